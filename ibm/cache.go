@@ -0,0 +1,170 @@
+package ibm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+)
+
+// cache memoizes API list results per region and collection for the lifetime
+// of a client. Concurrent lookups of the same key share a single request.
+// When a directory is set, results are also persisted to disk and reused
+// until they are older than the TTL.
+type cache struct {
+	mu      sync.Mutex
+	entries map[cacheKey]*cacheEntry
+
+	dir string
+	ttl time.Duration
+}
+
+type cacheKey struct {
+	region     string
+	collection string
+}
+
+type cacheEntry struct {
+	done  chan struct{}
+	items map[string]bool
+	err   error
+}
+
+// diskCacheEntry is the on-disk representation of a cached collection.
+type diskCacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Items     []string  `json:"items"`
+}
+
+func newCache() *cache {
+	return &cache{entries: map[cacheKey]*cacheEntry{}}
+}
+
+// DefaultCacheDir returns the directory used for the on-disk cache.
+func DefaultCacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".tflint.d", "cache", "ibm"), nil
+}
+
+// persist enables the on-disk cache. Entries are stored per account, identified
+// by a hash of the API key, so results of different accounts are never mixed.
+func (c *cache) persist(dir string, account string, ttl time.Duration) {
+	sum := sha256.Sum256([]byte(account))
+	c.dir = filepath.Join(dir, hex.EncodeToString(sum[:])[:16])
+	c.ttl = ttl
+}
+
+// get returns the cached collection, calling fetch only when no other caller
+// has fetched or is fetching the same key. Errors are not cached.
+func (c *cache) get(region, collection string, fetch func() (map[string]bool, error)) (map[string]bool, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	key := cacheKey{region: region, collection: collection}
+
+	c.mu.Lock()
+	if entry, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		<-entry.done
+		return entry.items, entry.err
+	}
+	entry := &cacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	entry.items, entry.err = c.load(key, fetch)
+	if entry.err != nil {
+		c.mu.Lock()
+		delete(c.entries, key)
+		c.mu.Unlock()
+	}
+	close(entry.done)
+
+	return entry.items, entry.err
+}
+
+func (c *cache) load(key cacheKey, fetch func() (map[string]bool, error)) (map[string]bool, error) {
+	if items, ok := c.read(key); ok {
+		return items, nil
+	}
+
+	items, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.write(key, items)
+	return items, nil
+}
+
+func (c *cache) path(key cacheKey) string {
+	return filepath.Join(c.dir, key.region, key.collection+".json")
+}
+
+func (c *cache) read(key cacheKey) (map[string]bool, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+
+	src, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var stored diskCacheEntry
+	if err := json.Unmarshal(src, &stored); err != nil {
+		logger.Debug("ignoring corrupt cache file %s: %s", c.path(key), err)
+		return nil, false
+	}
+	if time.Since(stored.FetchedAt) > c.ttl {
+		return nil, false
+	}
+
+	items := make(map[string]bool, len(stored.Items))
+	for _, item := range stored.Items {
+		items[item] = true
+	}
+	return items, true
+}
+
+// write stores the collection on disk. Failures only disable persistence
+// for this entry, as the in-memory result is still valid.
+func (c *cache) write(key cacheKey, items map[string]bool) {
+	if c.dir == "" {
+		return
+	}
+
+	stored := diskCacheEntry{FetchedAt: time.Now()}
+	for item := range items {
+		stored.Items = append(stored.Items, item)
+	}
+	sort.Strings(stored.Items)
+
+	src, err := json.Marshal(stored)
+	if err != nil {
+		logger.Debug("failed to encode cache entry: %s", err)
+		return
+	}
+
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		logger.Debug("failed to create cache directory: %s", err)
+		return
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, src, 0o600); err != nil {
+		logger.Debug("failed to write cache file: %s", err)
+		return
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		logger.Debug("failed to write cache file: %s", err)
+	}
+}
@@ -0,0 +1,190 @@
+package ibm
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fetchItems returns a fetch function that counts its calls
+func fetchItems(calls *int32, items ...string) func() (map[string]bool, error) {
+	return func() (map[string]bool, error) {
+		atomic.AddInt32(calls, 1)
+		result := map[string]bool{}
+		for _, item := range items {
+			result[item] = true
+		}
+		return result, nil
+	}
+}
+
+func TestCache_concurrentLookups(t *testing.T) {
+	c := newCache()
+
+	var calls int32
+	release := make(chan struct{})
+	fetch := func() (map[string]bool, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return map[string]bool{"bx2-2x8": true}, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]map[string]bool, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			items, err := c.get("us-south", "instance_profiles", fetch)
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = items
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("fetch was called %d times, want 1", calls)
+	}
+	for i, items := range results {
+		if !items["bx2-2x8"] {
+			t.Errorf("lookup %d returned %v", i, items)
+		}
+	}
+
+	// Other regions and collections are separate lookups
+	var otherCalls int32
+	if _, err := c.get("eu-de", "instance_profiles", fetchItems(&otherCalls)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.get("us-south", "images", fetchItems(&otherCalls)); err != nil {
+		t.Fatal(err)
+	}
+	if otherCalls != 2 {
+		t.Errorf("fetch was called %d times for other keys, want 2", otherCalls)
+	}
+}
+
+func TestCache_ttl(t *testing.T) {
+	dir := t.TempDir()
+	key := cacheKey{region: "us-south", collection: "images"}
+
+	var calls int32
+	first := newCache()
+	first.persist(dir, "api-key", time.Hour)
+	if _, err := first.get(key.region, key.collection, fetchItems(&calls, "image-1")); err != nil {
+		t.Fatal(err)
+	}
+
+	// A new client within the TTL reads the collection from disk
+	second := newCache()
+	second.persist(dir, "api-key", time.Hour)
+	items, err := second.get(key.region, key.collection, fetchItems(&calls, "image-2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || !items["image-1"] {
+		t.Errorf("got %v after %d fetches, want the persisted image-1", items, calls)
+	}
+
+	// Once the entry is older than the TTL, it is fetched again
+	path := first.path(key)
+	stored := diskCacheEntry{FetchedAt: time.Now().Add(-2 * time.Hour), Items: []string{"image-1"}}
+	src, err := json.Marshal(stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, src, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	third := newCache()
+	third.persist(dir, "api-key", time.Hour)
+	items, err = third.get(key.region, key.collection, fetchItems(&calls, "image-2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || !items["image-2"] {
+		t.Errorf("got %v after %d fetches, want a fresh image-2", items, calls)
+	}
+}
+
+func TestCache_accounts(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	dir, err := DefaultCacheDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(home, ".tflint.d", "cache", "ibm"); dir != want {
+		t.Fatalf("DefaultCacheDir() = %s, want %s", dir, want)
+	}
+
+	var calls int32
+	a := newCache()
+	a.persist(dir, "api-key-a", time.Hour)
+	if _, err := a.get("us-south", "images", fetchItems(&calls, "image-a")); err != nil {
+		t.Fatal(err)
+	}
+
+	b := newCache()
+	b.persist(dir, "api-key-b", time.Hour)
+	items, err := b.get("us-south", "images", fetchItems(&calls, "image-b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || items["image-a"] {
+		t.Errorf("account b got %v after %d fetches, want its own lookup", items, calls)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("want a directory per account in %s, got %d entries", dir, len(entries))
+	}
+	for _, c := range []*cache{a, b} {
+		if filepath.Dir(c.dir) != dir || len(filepath.Base(c.dir)) != 16 {
+			t.Errorf("cache directory %s is not a hash under %s", c.dir, dir)
+		}
+		if _, err := os.Stat(filepath.Join(c.dir, "us-south", "images.json")); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCache_errorsAreNotPersisted(t *testing.T) {
+	dir := t.TempDir()
+	c := newCache()
+	c.persist(dir, "api-key", time.Hour)
+
+	failure := errors.New("service unavailable")
+	_, err := c.get("us-south", "images", func() (map[string]bool, error) {
+		return nil, failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("error = %v, want %v", err, failure)
+	}
+	if _, err := os.Stat(c.path(cacheKey{region: "us-south", collection: "images"})); !os.IsNotExist(err) {
+		t.Errorf("the failed lookup was written to disk: %v", err)
+	}
+
+	var calls int32
+	items, err := c.get("us-south", "images", fetchItems(&calls, "image-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 || !items["image-1"] {
+		t.Errorf("got %v after %d fetches, want the lookup to be retried", items, calls)
+	}
+}
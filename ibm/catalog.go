@@ -0,0 +1,109 @@
+package ibm
+
+import "strings"
+
+//go:generate go run ../tools/catalog-gen -output catalog_gen.go
+
+// InstanceProfile is an offline description of a VPC virtual server instance profile.
+type InstanceProfile struct {
+	Name         string
+	Family       string
+	Architecture string
+	VCPU         int
+	MemoryGiB    int
+	GPUCount     int
+	GPUModel     string
+}
+
+// BareMetalProfile is an offline description of a VPC bare metal server profile.
+type BareMetalProfile struct {
+	Name         string
+	Family       string
+	Architecture string
+	CPUCores     int
+	MemoryGiB    int
+}
+
+// VolumeProfile is an offline description of a VPC block storage volume profile.
+// Capacities are in GB. Zero values mean the limit is not known.
+type VolumeProfile struct {
+	Name            string
+	Family          string
+	MinCapacity     int
+	MaxCapacity     int
+	MaxBootCapacity int
+	MinIOPS         int
+	MaxIOPS         int
+}
+
+// Image is an offline description of a stock (IBM-provided) VPC image.
+type Image struct {
+	Name                   string
+	OperatingSystem        string
+	OSFamily               string
+	Architecture           string
+	MinimumProvisionedSize int
+}
+
+// LookupInstanceProfile returns the catalog entry for the given instance profile name.
+func LookupInstanceProfile(name string) (InstanceProfile, bool) {
+	profile, ok := catalogInstanceProfiles[name]
+	return profile, ok
+}
+
+// retiredInstanceProfileFamilies maps the prefixes of retired instance profile
+// families to the prefixes of their successors. The API no longer lists retired
+// profiles, so they are maintained by hand rather than generated.
+var retiredInstanceProfileFamilies = map[string]string{
+	"bc1": "bx2",
+	"cc1": "cx2",
+	"mc1": "mx2",
+}
+
+// InstanceProfileSuccessor returns the current instance profile that replaces
+// a retired one, e.g. "bx2-2x8" for "bc1-2x8".
+func InstanceProfileSuccessor(name string) (string, bool) {
+	prefix, size, found := strings.Cut(name, "-")
+	if !found {
+		return "", false
+	}
+	successor, ok := retiredInstanceProfileFamilies[prefix]
+	if !ok {
+		return "", false
+	}
+	successor += "-" + size
+	if _, ok := catalogInstanceProfiles[successor]; !ok {
+		return "", false
+	}
+	return successor, true
+}
+
+// LookupBareMetalProfile returns the catalog entry for the given bare metal profile name.
+func LookupBareMetalProfile(name string) (BareMetalProfile, bool) {
+	profile, ok := catalogBareMetalProfiles[name]
+	return profile, ok
+}
+
+// LookupVolumeProfile returns the catalog entry for the given volume profile name.
+func LookupVolumeProfile(name string) (VolumeProfile, bool) {
+	profile, ok := catalogVolumeProfiles[name]
+	return profile, ok
+}
+
+// LookupImage returns the catalog entry for the given stock image name.
+func LookupImage(name string) (Image, bool) {
+	image, ok := catalogImages[name]
+	return image, ok
+}
+
+// RegionForZone returns the region the given zone belongs to.
+func RegionForZone(zone string) (string, bool) {
+	for region, zones := range catalogRegions {
+		for _, z := range zones {
+			if z == zone {
+				return region, true
+			}
+		}
+	}
+	return "", false
+}
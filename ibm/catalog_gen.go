@@ -0,0 +1,201 @@
+// The entries below are maintained by hand from the IBM Cloud documentation.
+// Run `make catalog` with IBMCLOUD_API_KEY set to regenerate this file from
+// the VPC API with tools/catalog-gen.
+
+package ibm
+
+// CatalogVersion is the date the offline catalog was generated from the VPC API.
+const CatalogVersion = "2025-01-20"
+
+var catalogInstanceProfiles = map[string]InstanceProfile{
+	"bx2-128x512":         {Name: "bx2-128x512", Family: "balanced", Architecture: "amd64", VCPU: 128, MemoryGiB: 512},
+	"bx2-16x64":           {Name: "bx2-16x64", Family: "balanced", Architecture: "amd64", VCPU: 16, MemoryGiB: 64},
+	"bx2-2x8":             {Name: "bx2-2x8", Family: "balanced", Architecture: "amd64", VCPU: 2, MemoryGiB: 8},
+	"bx2-32x128":          {Name: "bx2-32x128", Family: "balanced", Architecture: "amd64", VCPU: 32, MemoryGiB: 128},
+	"bx2-48x192":          {Name: "bx2-48x192", Family: "balanced", Architecture: "amd64", VCPU: 48, MemoryGiB: 192},
+	"bx2-4x16":            {Name: "bx2-4x16", Family: "balanced", Architecture: "amd64", VCPU: 4, MemoryGiB: 16},
+	"bx2-64x256":          {Name: "bx2-64x256", Family: "balanced", Architecture: "amd64", VCPU: 64, MemoryGiB: 256},
+	"bx2-8x32":            {Name: "bx2-8x32", Family: "balanced", Architecture: "amd64", VCPU: 8, MemoryGiB: 32},
+	"bx2-96x384":          {Name: "bx2-96x384", Family: "balanced", Architecture: "amd64", VCPU: 96, MemoryGiB: 384},
+	"bx2d-128x512":        {Name: "bx2d-128x512", Family: "balanced", Architecture: "amd64", VCPU: 128, MemoryGiB: 512},
+	"bx2d-16x64":          {Name: "bx2d-16x64", Family: "balanced", Architecture: "amd64", VCPU: 16, MemoryGiB: 64},
+	"bx2d-2x8":            {Name: "bx2d-2x8", Family: "balanced", Architecture: "amd64", VCPU: 2, MemoryGiB: 8},
+	"bx2d-32x128":         {Name: "bx2d-32x128", Family: "balanced", Architecture: "amd64", VCPU: 32, MemoryGiB: 128},
+	"bx2d-48x192":         {Name: "bx2d-48x192", Family: "balanced", Architecture: "amd64", VCPU: 48, MemoryGiB: 192},
+	"bx2d-4x16":           {Name: "bx2d-4x16", Family: "balanced", Architecture: "amd64", VCPU: 4, MemoryGiB: 16},
+	"bx2d-64x256":         {Name: "bx2d-64x256", Family: "balanced", Architecture: "amd64", VCPU: 64, MemoryGiB: 256},
+	"bx2d-8x32":           {Name: "bx2d-8x32", Family: "balanced", Architecture: "amd64", VCPU: 8, MemoryGiB: 32},
+	"bx2d-96x384":         {Name: "bx2d-96x384", Family: "balanced", Architecture: "amd64", VCPU: 96, MemoryGiB: 384},
+	"bx3d-128x640":        {Name: "bx3d-128x640", Family: "balanced", Architecture: "amd64", VCPU: 128, MemoryGiB: 640},
+	"bx3d-16x80":          {Name: "bx3d-16x80", Family: "balanced", Architecture: "amd64", VCPU: 16, MemoryGiB: 80},
+	"bx3d-176x880":        {Name: "bx3d-176x880", Family: "balanced", Architecture: "amd64", VCPU: 176, MemoryGiB: 880},
+	"bx3d-24x120":         {Name: "bx3d-24x120", Family: "balanced", Architecture: "amd64", VCPU: 24, MemoryGiB: 120},
+	"bx3d-2x10":           {Name: "bx3d-2x10", Family: "balanced", Architecture: "amd64", VCPU: 2, MemoryGiB: 10},
+	"bx3d-32x160":         {Name: "bx3d-32x160", Family: "balanced", Architecture: "amd64", VCPU: 32, MemoryGiB: 160},
+	"bx3d-48x240":         {Name: "bx3d-48x240", Family: "balanced", Architecture: "amd64", VCPU: 48, MemoryGiB: 240},
+	"bx3d-4x20":           {Name: "bx3d-4x20", Family: "balanced", Architecture: "amd64", VCPU: 4, MemoryGiB: 20},
+	"bx3d-64x320":         {Name: "bx3d-64x320", Family: "balanced", Architecture: "amd64", VCPU: 64, MemoryGiB: 320},
+	"bx3d-8x40":           {Name: "bx3d-8x40", Family: "balanced", Architecture: "amd64", VCPU: 8, MemoryGiB: 40},
+	"bx3d-96x480":         {Name: "bx3d-96x480", Family: "balanced", Architecture: "amd64", VCPU: 96, MemoryGiB: 480},
+	"bz2-16x64":           {Name: "bz2-16x64", Family: "balanced", Architecture: "s390x", VCPU: 16, MemoryGiB: 64},
+	"bz2-2x8":             {Name: "bz2-2x8", Family: "balanced", Architecture: "s390x", VCPU: 2, MemoryGiB: 8},
+	"bz2-4x16":            {Name: "bz2-4x16", Family: "balanced", Architecture: "s390x", VCPU: 4, MemoryGiB: 16},
+	"bz2-8x32":            {Name: "bz2-8x32", Family: "balanced", Architecture: "s390x", VCPU: 8, MemoryGiB: 32},
+	"cx2-128x256":         {Name: "cx2-128x256", Family: "compute", Architecture: "amd64", VCPU: 128, MemoryGiB: 256},
+	"cx2-16x32":           {Name: "cx2-16x32", Family: "compute", Architecture: "amd64", VCPU: 16, MemoryGiB: 32},
+	"cx2-2x4":             {Name: "cx2-2x4", Family: "compute", Architecture: "amd64", VCPU: 2, MemoryGiB: 4},
+	"cx2-32x64":           {Name: "cx2-32x64", Family: "compute", Architecture: "amd64", VCPU: 32, MemoryGiB: 64},
+	"cx2-48x96":           {Name: "cx2-48x96", Family: "compute", Architecture: "amd64", VCPU: 48, MemoryGiB: 96},
+	"cx2-4x8":             {Name: "cx2-4x8", Family: "compute", Architecture: "amd64", VCPU: 4, MemoryGiB: 8},
+	"cx2-64x128":          {Name: "cx2-64x128", Family: "compute", Architecture: "amd64", VCPU: 64, MemoryGiB: 128},
+	"cx2-8x16":            {Name: "cx2-8x16", Family: "compute", Architecture: "amd64", VCPU: 8, MemoryGiB: 16},
+	"cx2-96x192":          {Name: "cx2-96x192", Family: "compute", Architecture: "amd64", VCPU: 96, MemoryGiB: 192},
+	"cx2d-128x256":        {Name: "cx2d-128x256", Family: "compute", Architecture: "amd64", VCPU: 128, MemoryGiB: 256},
+	"cx2d-16x32":          {Name: "cx2d-16x32", Family: "compute", Architecture: "amd64", VCPU: 16, MemoryGiB: 32},
+	"cx2d-2x4":            {Name: "cx2d-2x4", Family: "compute", Architecture: "amd64", VCPU: 2, MemoryGiB: 4},
+	"cx2d-32x64":          {Name: "cx2d-32x64", Family: "compute", Architecture: "amd64", VCPU: 32, MemoryGiB: 64},
+	"cx2d-48x96":          {Name: "cx2d-48x96", Family: "compute", Architecture: "amd64", VCPU: 48, MemoryGiB: 96},
+	"cx2d-4x8":            {Name: "cx2d-4x8", Family: "compute", Architecture: "amd64", VCPU: 4, MemoryGiB: 8},
+	"cx2d-64x128":         {Name: "cx2d-64x128", Family: "compute", Architecture: "amd64", VCPU: 64, MemoryGiB: 128},
+	"cx2d-8x16":           {Name: "cx2d-8x16", Family: "compute", Architecture: "amd64", VCPU: 8, MemoryGiB: 16},
+	"cx2d-96x192":         {Name: "cx2d-96x192", Family: "compute", Architecture: "amd64", VCPU: 96, MemoryGiB: 192},
+	"cx3d-128x320":        {Name: "cx3d-128x320", Family: "compute", Architecture: "amd64", VCPU: 128, MemoryGiB: 320},
+	"cx3d-16x40":          {Name: "cx3d-16x40", Family: "compute", Architecture: "amd64", VCPU: 16, MemoryGiB: 40},
+	"cx3d-176x440":        {Name: "cx3d-176x440", Family: "compute", Architecture: "amd64", VCPU: 176, MemoryGiB: 440},
+	"cx3d-24x60":          {Name: "cx3d-24x60", Family: "compute", Architecture: "amd64", VCPU: 24, MemoryGiB: 60},
+	"cx3d-2x5":            {Name: "cx3d-2x5", Family: "compute", Architecture: "amd64", VCPU: 2, MemoryGiB: 5},
+	"cx3d-32x80":          {Name: "cx3d-32x80", Family: "compute", Architecture: "amd64", VCPU: 32, MemoryGiB: 80},
+	"cx3d-48x120":         {Name: "cx3d-48x120", Family: "compute", Architecture: "amd64", VCPU: 48, MemoryGiB: 120},
+	"cx3d-4x10":           {Name: "cx3d-4x10", Family: "compute", Architecture: "amd64", VCPU: 4, MemoryGiB: 10},
+	"cx3d-64x160":         {Name: "cx3d-64x160", Family: "compute", Architecture: "amd64", VCPU: 64, MemoryGiB: 160},
+	"cx3d-8x20":           {Name: "cx3d-8x20", Family: "compute", Architecture: "amd64", VCPU: 8, MemoryGiB: 20},
+	"cx3d-96x240":         {Name: "cx3d-96x240", Family: "compute", Architecture: "amd64", VCPU: 96, MemoryGiB: 240},
+	"cz2-16x32":           {Name: "cz2-16x32", Family: "compute", Architecture: "s390x", VCPU: 16, MemoryGiB: 32},
+	"cz2-2x4":             {Name: "cz2-2x4", Family: "compute", Architecture: "s390x", VCPU: 2, MemoryGiB: 4},
+	"cz2-4x8":             {Name: "cz2-4x8", Family: "compute", Architecture: "s390x", VCPU: 4, MemoryGiB: 8},
+	"cz2-8x16":            {Name: "cz2-8x16", Family: "compute", Architecture: "s390x", VCPU: 8, MemoryGiB: 16},
+	"gx2-16x128x1v100":    {Name: "gx2-16x128x1v100", Family: "gpu", Architecture: "amd64", VCPU: 16, MemoryGiB: 128, GPUCount: 1, GPUModel: "Tesla V100"},
+	"gx2-16x128x2v100":    {Name: "gx2-16x128x2v100", Family: "gpu", Architecture: "amd64", VCPU: 16, MemoryGiB: 128, GPUCount: 2, GPUModel: "Tesla V100"},
+	"gx2-32x256x2v100":    {Name: "gx2-32x256x2v100", Family: "gpu", Architecture: "amd64", VCPU: 32, MemoryGiB: 256, GPUCount: 2, GPUModel: "Tesla V100"},
+	"gx2-8x64x1v100":      {Name: "gx2-8x64x1v100", Family: "gpu", Architecture: "amd64", VCPU: 8, MemoryGiB: 64, GPUCount: 1, GPUModel: "Tesla V100"},
+	"gx3-16x80x1l4":       {Name: "gx3-16x80x1l4", Family: "gpu", Architecture: "amd64", VCPU: 16, MemoryGiB: 80, GPUCount: 1, GPUModel: "L4"},
+	"gx3-24x120x1l40":     {Name: "gx3-24x120x1l40", Family: "gpu", Architecture: "amd64", VCPU: 24, MemoryGiB: 120, GPUCount: 1, GPUModel: "L40S"},
+	"gx3-32x160x2l4":      {Name: "gx3-32x160x2l4", Family: "gpu", Architecture: "amd64", VCPU: 32, MemoryGiB: 160, GPUCount: 2, GPUModel: "L4"},
+	"gx3-48x240x2l40":     {Name: "gx3-48x240x2l40", Family: "gpu", Architecture: "amd64", VCPU: 48, MemoryGiB: 240, GPUCount: 2, GPUModel: "L40S"},
+	"gx3-64x320x4l4":      {Name: "gx3-64x320x4l4", Family: "gpu", Architecture: "amd64", VCPU: 64, MemoryGiB: 320, GPUCount: 4, GPUModel: "L4"},
+	"gx3d-160x1792x8h100": {Name: "gx3d-160x1792x8h100", Family: "gpu", Architecture: "amd64", VCPU: 160, MemoryGiB: 1792, GPUCount: 8, GPUModel: "H100"},
+	"mx2-128x1024":        {Name: "mx2-128x1024", Family: "memory", Architecture: "amd64", VCPU: 128, MemoryGiB: 1024},
+	"mx2-16x128":          {Name: "mx2-16x128", Family: "memory", Architecture: "amd64", VCPU: 16, MemoryGiB: 128},
+	"mx2-2x16":            {Name: "mx2-2x16", Family: "memory", Architecture: "amd64", VCPU: 2, MemoryGiB: 16},
+	"mx2-32x256":          {Name: "mx2-32x256", Family: "memory", Architecture: "amd64", VCPU: 32, MemoryGiB: 256},
+	"mx2-48x384":          {Name: "mx2-48x384", Family: "memory", Architecture: "amd64", VCPU: 48, MemoryGiB: 384},
+	"mx2-4x32":            {Name: "mx2-4x32", Family: "memory", Architecture: "amd64", VCPU: 4, MemoryGiB: 32},
+	"mx2-64x512":          {Name: "mx2-64x512", Family: "memory", Architecture: "amd64", VCPU: 64, MemoryGiB: 512},
+	"mx2-8x64":            {Name: "mx2-8x64", Family: "memory", Architecture: "amd64", VCPU: 8, MemoryGiB: 64},
+	"mx2-96x768":          {Name: "mx2-96x768", Family: "memory", Architecture: "amd64", VCPU: 96, MemoryGiB: 768},
+	"mx2d-128x1024":       {Name: "mx2d-128x1024", Family: "memory", Architecture: "amd64", VCPU: 128, MemoryGiB: 1024},
+	"mx2d-16x128":         {Name: "mx2d-16x128", Family: "memory", Architecture: "amd64", VCPU: 16, MemoryGiB: 128},
+	"mx2d-2x16":           {Name: "mx2d-2x16", Family: "memory", Architecture: "amd64", VCPU: 2, MemoryGiB: 16},
+	"mx2d-32x256":         {Name: "mx2d-32x256", Family: "memory", Architecture: "amd64", VCPU: 32, MemoryGiB: 256},
+	"mx2d-48x384":         {Name: "mx2d-48x384", Family: "memory", Architecture: "amd64", VCPU: 48, MemoryGiB: 384},
+	"mx2d-4x32":           {Name: "mx2d-4x32", Family: "memory", Architecture: "amd64", VCPU: 4, MemoryGiB: 32},
+	"mx2d-64x512":         {Name: "mx2d-64x512", Family: "memory", Architecture: "amd64", VCPU: 64, MemoryGiB: 512},
+	"mx2d-8x64":           {Name: "mx2d-8x64", Family: "memory", Architecture: "amd64", VCPU: 8, MemoryGiB: 64},
+	"mx2d-96x768":         {Name: "mx2d-96x768", Family: "memory", Architecture: "amd64", VCPU: 96, MemoryGiB: 768},
+	"mx3d-128x1280":       {Name: "mx3d-128x1280", Family: "memory", Architecture: "amd64", VCPU: 128, MemoryGiB: 1280},
+	"mx3d-16x160":         {Name: "mx3d-16x160", Family: "memory", Architecture: "amd64", VCPU: 16, MemoryGiB: 160},
+	"mx3d-176x1760":       {Name: "mx3d-176x1760", Family: "memory", Architecture: "amd64", VCPU: 176, MemoryGiB: 1760},
+	"mx3d-24x240":         {Name: "mx3d-24x240", Family: "memory", Architecture: "amd64", VCPU: 24, MemoryGiB: 240},
+	"mx3d-2x20":           {Name: "mx3d-2x20", Family: "memory", Architecture: "amd64", VCPU: 2, MemoryGiB: 20},
+	"mx3d-32x320":         {Name: "mx3d-32x320", Family: "memory", Architecture: "amd64", VCPU: 32, MemoryGiB: 320},
+	"mx3d-48x480":         {Name: "mx3d-48x480", Family: "memory", Architecture: "amd64", VCPU: 48, MemoryGiB: 480},
+	"mx3d-4x40":           {Name: "mx3d-4x40", Family: "memory", Architecture: "amd64", VCPU: 4, MemoryGiB: 40},
+	"mx3d-64x640":         {Name: "mx3d-64x640", Family: "memory", Architecture: "amd64", VCPU: 64, MemoryGiB: 640},
+	"mx3d-8x80":           {Name: "mx3d-8x80", Family: "memory", Architecture: "amd64", VCPU: 8, MemoryGiB: 80},
+	"mx3d-96x960":         {Name: "mx3d-96x960", Family: "memory", Architecture: "amd64", VCPU: 96, MemoryGiB: 960},
+	"mz2-16x128":          {Name: "mz2-16x128", Family: "memory", Architecture: "s390x", VCPU: 16, MemoryGiB: 128},
+	"mz2-2x16":            {Name: "mz2-2x16", Family: "memory", Architecture: "s390x", VCPU: 2, MemoryGiB: 16},
+	"mz2-4x32":            {Name: "mz2-4x32", Family: "memory", Architecture: "s390x", VCPU: 4, MemoryGiB: 32},
+	"mz2-8x64":            {Name: "mz2-8x64", Family: "memory", Architecture: "s390x", VCPU: 8, MemoryGiB: 64},
+	"ox2-128x1024":        {Name: "ox2-128x1024", Family: "storage-optimized", Architecture: "amd64", VCPU: 128, MemoryGiB: 1024},
+	"ox2-16x128":          {Name: "ox2-16x128", Family: "storage-optimized", Architecture: "amd64", VCPU: 16, MemoryGiB: 128},
+	"ox2-2x16":            {Name: "ox2-2x16", Family: "storage-optimized", Architecture: "amd64", VCPU: 2, MemoryGiB: 16},
+	"ox2-32x256":          {Name: "ox2-32x256", Family: "storage-optimized", Architecture: "amd64", VCPU: 32, MemoryGiB: 256},
+	"ox2-4x32":            {Name: "ox2-4x32", Family: "storage-optimized", Architecture: "amd64", VCPU: 4, MemoryGiB: 32},
+	"ox2-64x512":          {Name: "ox2-64x512", Family: "storage-optimized", Architecture: "amd64", VCPU: 64, MemoryGiB: 512},
+	"ox2-8x64":            {Name: "ox2-8x64", Family: "storage-optimized", Architecture: "amd64", VCPU: 8, MemoryGiB: 64},
+	"ox2-96x768":          {Name: "ox2-96x768", Family: "storage-optimized", Architecture: "amd64", VCPU: 96, MemoryGiB: 768},
+	"ux2d-100x2800":       {Name: "ux2d-100x2800", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 100, MemoryGiB: 2800},
+	"ux2d-16x448":         {Name: "ux2d-16x448", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 16, MemoryGiB: 448},
+	"ux2d-200x5600":       {Name: "ux2d-200x5600", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 200, MemoryGiB: 5600},
+	"ux2d-2x56":           {Name: "ux2d-2x56", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 2, MemoryGiB: 56},
+	"ux2d-36x1008":        {Name: "ux2d-36x1008", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 36, MemoryGiB: 1008},
+	"ux2d-48x1344":        {Name: "ux2d-48x1344", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 48, MemoryGiB: 1344},
+	"ux2d-4x112":          {Name: "ux2d-4x112", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 4, MemoryGiB: 112},
+	"ux2d-72x2016":        {Name: "ux2d-72x2016", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 72, MemoryGiB: 2016},
+	"ux2d-8x224":          {Name: "ux2d-8x224", Family: "ultra-high-memory", Architecture: "amd64", VCPU: 8, MemoryGiB: 224},
+	"vx2d-144x2016":       {Name: "vx2d-144x2016", Family: "very-high-memory", Architecture: "amd64", VCPU: 144, MemoryGiB: 2016},
+	"vx2d-16x224":         {Name: "vx2d-16x224", Family: "very-high-memory", Architecture: "amd64", VCPU: 16, MemoryGiB: 224},
+	"vx2d-176x2464":       {Name: "vx2d-176x2464", Family: "very-high-memory", Architecture: "amd64", VCPU: 176, MemoryGiB: 2464},
+	"vx2d-2x28":           {Name: "vx2d-2x28", Family: "very-high-memory", Architecture: "amd64", VCPU: 2, MemoryGiB: 28},
+	"vx2d-44x616":         {Name: "vx2d-44x616", Family: "very-high-memory", Architecture: "amd64", VCPU: 44, MemoryGiB: 616},
+	"vx2d-4x56":           {Name: "vx2d-4x56", Family: "very-high-memory", Architecture: "amd64", VCPU: 4, MemoryGiB: 56},
+	"vx2d-88x1232":        {Name: "vx2d-88x1232", Family: "very-high-memory", Architecture: "amd64", VCPU: 88, MemoryGiB: 1232},
+	"vx2d-8x112":          {Name: "vx2d-8x112", Family: "very-high-memory", Architecture: "amd64", VCPU: 8, MemoryGiB: 112},
+}
+
+var catalogBareMetalProfiles = map[string]BareMetalProfile{
+	"bx2-metal-192x768":  {Name: "bx2-metal-192x768", Family: "balanced", Architecture: "amd64", CPUCores: 96, MemoryGiB: 768},
+	"bx2-metal-96x384":   {Name: "bx2-metal-96x384", Family: "balanced", Architecture: "amd64", CPUCores: 48, MemoryGiB: 384},
+	"bx2d-metal-192x768": {Name: "bx2d-metal-192x768", Family: "balanced", Architecture: "amd64", CPUCores: 96, MemoryGiB: 768},
+	"bx2d-metal-96x384":  {Name: "bx2d-metal-96x384", Family: "balanced", Architecture: "amd64", CPUCores: 48, MemoryGiB: 384},
+	"bx3d-metal-48x256":  {Name: "bx3d-metal-48x256", Family: "balanced", Architecture: "amd64", CPUCores: 24, MemoryGiB: 256},
+	"bx3d-metal-64x256":  {Name: "bx3d-metal-64x256", Family: "balanced", Architecture: "amd64", CPUCores: 32, MemoryGiB: 256},
+	"cx2-metal-96x192":   {Name: "cx2-metal-96x192", Family: "compute", Architecture: "amd64", CPUCores: 48, MemoryGiB: 192},
+	"cx2d-metal-96x192":  {Name: "cx2d-metal-96x192", Family: "compute", Architecture: "amd64", CPUCores: 48, MemoryGiB: 192},
+	"cx3d-metal-48x128":  {Name: "cx3d-metal-48x128", Family: "compute", Architecture: "amd64", CPUCores: 24, MemoryGiB: 128},
+	"cx3d-metal-64x128":  {Name: "cx3d-metal-64x128", Family: "compute", Architecture: "amd64", CPUCores: 32, MemoryGiB: 128},
+	"mx2-metal-96x768":   {Name: "mx2-metal-96x768", Family: "memory", Architecture: "amd64", CPUCores: 48, MemoryGiB: 768},
+	"mx2d-metal-96x768":  {Name: "mx2d-metal-96x768", Family: "memory", Architecture: "amd64", CPUCores: 48, MemoryGiB: 768},
+	"mx3d-metal-48x512":  {Name: "mx3d-metal-48x512", Family: "memory", Architecture: "amd64", CPUCores: 24, MemoryGiB: 512},
+	"mx3d-metal-64x512":  {Name: "mx3d-metal-64x512", Family: "memory", Architecture: "amd64", CPUCores: 32, MemoryGiB: 512},
+}
+
+var catalogVolumeProfiles = map[string]VolumeProfile{
+	"10iops-tier":     {Name: "10iops-tier", Family: "tiered", MinCapacity: 10, MaxCapacity: 4800, MaxBootCapacity: 250, MinIOPS: 0, MaxIOPS: 0},
+	"5iops-tier":      {Name: "5iops-tier", Family: "tiered", MinCapacity: 10, MaxCapacity: 9600, MaxBootCapacity: 250, MinIOPS: 0, MaxIOPS: 0},
+	"custom":          {Name: "custom", Family: "custom", MinCapacity: 10, MaxCapacity: 16000, MaxBootCapacity: 250, MinIOPS: 100, MaxIOPS: 48000},
+	"general-purpose": {Name: "general-purpose", Family: "tiered", MinCapacity: 10, MaxCapacity: 16000, MaxBootCapacity: 250, MinIOPS: 0, MaxIOPS: 0},
+	"sdp":             {Name: "sdp", Family: "defined_performance", MinCapacity: 1, MaxCapacity: 32000, MaxBootCapacity: 32000, MinIOPS: 3000, MaxIOPS: 64000},
+}
+
+var catalogImages = map[string]Image{
+	"ibm-centos-stream-9-amd64-11":                   {Name: "ibm-centos-stream-9-amd64-11", OperatingSystem: "centos-stream-9-amd64", OSFamily: "CentOS Stream", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-debian-12-8-minimal-amd64-1":                {Name: "ibm-debian-12-8-minimal-amd64-1", OperatingSystem: "debian-12-amd64", OSFamily: "Debian GNU/Linux", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-fedora-coreos-40-stable-2":                  {Name: "ibm-fedora-coreos-40-stable-2", OperatingSystem: "fedora-coreos-stable-amd64", OSFamily: "Fedora CoreOS", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-redhat-8-10-minimal-amd64-4":                {Name: "ibm-redhat-8-10-minimal-amd64-4", OperatingSystem: "red-8-amd64", OSFamily: "Red Hat Enterprise Linux", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-redhat-9-4-minimal-amd64-5":                 {Name: "ibm-redhat-9-4-minimal-amd64-5", OperatingSystem: "red-9-amd64", OSFamily: "Red Hat Enterprise Linux", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-redhat-9-4-minimal-s390x-3":                 {Name: "ibm-redhat-9-4-minimal-s390x-3", OperatingSystem: "red-9-s390x", OSFamily: "Red Hat Enterprise Linux", Architecture: "s390x", MinimumProvisionedSize: 100},
+	"ibm-rocky-linux-9-4-minimal-amd64-3":            {Name: "ibm-rocky-linux-9-4-minimal-amd64-3", OperatingSystem: "rocky-linux-9-amd64", OSFamily: "Rocky Linux", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-sles-15-6-amd64-1":                          {Name: "ibm-sles-15-6-amd64-1", OperatingSystem: "sles-15-amd64", OSFamily: "SUSE Linux Enterprise Server", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-ubuntu-20-04-6-minimal-amd64-6":             {Name: "ibm-ubuntu-20-04-6-minimal-amd64-6", OperatingSystem: "ubuntu-20-04-amd64", OSFamily: "Ubuntu Linux", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-ubuntu-22-04-4-minimal-s390x-3":             {Name: "ibm-ubuntu-22-04-4-minimal-s390x-3", OperatingSystem: "ubuntu-22-04-s390x", OSFamily: "Ubuntu Linux", Architecture: "s390x", MinimumProvisionedSize: 100},
+	"ibm-ubuntu-22-04-5-minimal-amd64-1":             {Name: "ibm-ubuntu-22-04-5-minimal-amd64-1", OperatingSystem: "ubuntu-22-04-amd64", OSFamily: "Ubuntu Linux", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-ubuntu-24-04-6-minimal-amd64-1":             {Name: "ibm-ubuntu-24-04-6-minimal-amd64-1", OperatingSystem: "ubuntu-24-04-amd64", OSFamily: "Ubuntu Linux", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-windows-server-2019-full-standard-amd64-21": {Name: "ibm-windows-server-2019-full-standard-amd64-21", OperatingSystem: "windows-2019-amd64", OSFamily: "Windows Server", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-windows-server-2022-full-standard-amd64-16": {Name: "ibm-windows-server-2022-full-standard-amd64-16", OperatingSystem: "windows-2022-amd64", OSFamily: "Windows Server", Architecture: "amd64", MinimumProvisionedSize: 100},
+	"ibm-windows-server-2025-full-standard-amd64-2":  {Name: "ibm-windows-server-2025-full-standard-amd64-2", OperatingSystem: "windows-2025-amd64", OSFamily: "Windows Server", Architecture: "amd64", MinimumProvisionedSize: 100},
+}
+
+var catalogRegions = map[string][]string{
+	"au-syd":   {"au-syd-1", "au-syd-2", "au-syd-3"},
+	"br-sao":   {"br-sao-1", "br-sao-2", "br-sao-3"},
+	"ca-tor":   {"ca-tor-1", "ca-tor-2", "ca-tor-3"},
+	"eu-de":    {"eu-de-1", "eu-de-2", "eu-de-3"},
+	"eu-es":    {"eu-es-1", "eu-es-2", "eu-es-3"},
+	"eu-gb":    {"eu-gb-1", "eu-gb-2", "eu-gb-3"},
+	"jp-osa":   {"jp-osa-1", "jp-osa-2", "jp-osa-3"},
+	"jp-tok":   {"jp-tok-1", "jp-tok-2", "jp-tok-3"},
+	"us-east":  {"us-east-1", "us-east-2", "us-east-3"},
+	"us-south": {"us-south-1", "us-south-2", "us-south-3"},
+}
@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"time"
 
-	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
@@ -17,33 +16,64 @@ const (
 )
 
 type IBMClient struct {
-	VPC *vpcv1.VpcV1
+	VPC      *vpcv1.VpcV1
+	region   string
+	cache    *cache
+	pageSize int64
+	maxPages int
 }
 
 type Credentials struct {
-	APIKey         string
-	Region         string
-	Zone           string
-	IAMAccessToken string
-	Timeout        int
+	APIKey          string
+	Region          string
+	Zone            string
+	IAMAccessToken  string
+	IAMRefreshToken string
+	Timeout         int
+
+	// IAMProfileID and IAMProfileName select a trusted profile, which is
+	// authenticated with the compute resource token of the environment.
+	// CRTokenFilename overrides the location of the token inside containers.
+	IAMProfileID    string
+	IAMProfileName  string
+	CRTokenFilename string
+
+	// VPCEndpoint and IAMEndpoint override the public IBM Cloud endpoints,
+	// e.g. to use private endpoints or a local fake API.
+	VPCEndpoint string
+	IAMEndpoint string
+
+	// HTTPClient is used for both IAM and VPC requests when set,
+	// e.g. to replay recorded responses in tests.
+	HTTPClient *http.Client
+
+	// CacheDir enables the on-disk response cache when set.
+	// Cached collections are reused until they are older than CacheTTL.
+	CacheDir string
+	CacheTTL time.Duration
+
+	// PageSize and MaxPages control how collections are paginated.
+	// Zero values fall back to DefaultPageSize and DefaultMaxPages.
+	PageSize int64
+	MaxPages int
 }
 
 func NewClient(creds Credentials) (*IBMClient, error) {
-	if creds.APIKey == "" {
-		return nil, fmt.Errorf("API key is required")
-	}
 	if creds.Region == "" {
 		return nil, fmt.Errorf("region is required")
 	}
-	authenticator := &core.IamAuthenticator{
-		ApiKey: creds.APIKey,
+	authenticator, err := creds.authenticator()
+	if err != nil {
+		return nil, err
 	}
 
 	vpcOptions := &vpcv1.VpcV1Options{
 		Authenticator: authenticator,
 	}
 
-	if creds.Region != "" {
+	if creds.VPCEndpoint != "" {
+		vpcOptions.URL = creds.VPCEndpoint
+	} else if creds.Region != "" {
 		vpcOptions.URL = fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", creds.Region)
 	}
 
@@ -56,16 +86,53 @@ func NewClient(creds Credentials) (*IBMClient, error) {
 		creds.Timeout = int(DefaultTimeout.Seconds())
 	}
 
-	vpcClient.Service.EnableRetries(creds.Timeout, RetryDelay)
+	// An injected HTTP client owns its retry behavior, so failures such as
+	// a missing recorded response are reported immediately.
+	if creds.HTTPClient != nil {
+		vpcClient.Service.SetHTTPClient(creds.HTTPClient)
+	} else {
+		vpcClient.Service.EnableRetries(creds.Timeout, RetryDelay)
+	}
 	vpcClient.SetDefaultHeaders(http.Header{
 		"User-Agent": []string{fmt.Sprintf("tflint-ruleset-ibm/%s", "0.1.0")},
 	})
 
+	// Short-lived tokens do not identify an account, so only stable identities are persisted
+	responseCache := newCache()
+	if account := firstNonEmpty(creds.APIKey, creds.IAMProfileID, creds.IAMProfileName); creds.CacheDir != "" && account != "" {
+		responseCache.persist(creds.CacheDir, account, creds.CacheTTL)
+	}
+
+	if creds.PageSize == 0 {
+		creds.PageSize = DefaultPageSize
+	}
+	if creds.MaxPages == 0 {
+		creds.MaxPages = DefaultMaxPages
+	}
+
 	return &IBMClient{
-		VPC: vpcClient,
+		VPC:      vpcClient,
+		region:   creds.Region,
+		cache:    responseCache,
+		pageSize: creds.PageSize,
+		maxPages: creds.MaxPages,
 	}, nil
 }
 
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// Region returns the region the client sends requests to.
+func (c *IBMClient) Region() string {
+	return c.region
+}
+
 func (c *IBMClient) ValidateVPC(ctx context.Context, vpcID string) (bool, error) {
 	options := &vpcv1.GetVPCOptions{
 		ID: &vpcID,
@@ -83,20 +150,13 @@ func (c *IBMClient) ValidateVPC(ctx context.Context, vpcID string) (bool, error)
 }
 
 func (c *IBMClient) ValidateInstanceProfile(ctx context.Context, profileName string) (bool, error) {
-	options := &vpcv1.ListInstanceProfilesOptions{}
-	result, _, err := c.VPC.ListInstanceProfilesWithContext(ctx, options)
+	profiles, err := c.GetInstanceProfiles(ctx)
 	if err != nil {
-		return false, fmt.Errorf("failed to list instance profiles: %w", err)
+		return false, err
 	}
-
-	for _, profile := range result.Profiles {
-		if *profile.Name == profileName {
-			return true, nil
-		}
-	}
-
-	return false, nil
+	return profiles[profileName], nil
 }
+
 func (c *IBMClient) ValidateBackupPolicy(ctx context.Context, policyID string) (bool, error) {
 	options := &vpcv1.GetBackupPolicyOptions{
 		ID: &policyID,
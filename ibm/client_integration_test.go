@@ -0,0 +1,85 @@
+//go:build integration
+
+package ibm_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/ibm/ibmtest"
+)
+
+func TestIBMClient_collections(t *testing.T) {
+	server := ibmtest.NewServer(ibmtest.DefaultFixtures())
+	defer server.Close()
+
+	client, err := ibm.NewClient(ibm.Credentials{
+		APIKey:      ibmtest.APIKey,
+		Region:      "us-south",
+		VPCEndpoint: server.VPCEndpoint(),
+		IAMEndpoint: server.IAMEndpoint(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		list func(context.Context) (map[string]bool, error)
+		want []string
+	}{
+		{name: "images", list: client.GetImages, want: []string{"r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"}},
+		{name: "instance profiles", list: client.GetInstanceProfiles, want: []string{"bx2-2x8"}},
+		{name: "backup policies", list: client.GetBackupPolicies, want: []string{"r006-0fe9e5d8-0a4c-4818-96ec-e99ac3e4b8d1"}},
+		{name: "subnets", list: client.GetSubnets, want: []string{"0717-7931845c-65c4-4b0a-80cd-7d9c1a6d7930", "my-subnet"}},
+		{name: "security groups", list: client.GetSecurityGroups, want: []string{"r006-be5df5ca-12a0-494b-907e-aa6ec2bfa271", "my-security-group"}},
+		{name: "SSH keys", list: client.GetSSHKeys, want: []string{"r006-0f1e2d3c-4b5a-4697-8877-665544332211", "my-key"}},
+		{name: "volumes", list: client.GetVolumes, want: []string{"r006-2c4e6a8b-0d1f-4a3c-9e5b-7d9f1b3d5f7a", "my-volume"}},
+		{name: "volume profiles", list: client.GetVolumeProfiles, want: []string{"general-purpose", "custom", "sdp"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.list(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range tc.want {
+				if !got[key] {
+					t.Errorf("%s is missing from %v", key, got)
+				}
+			}
+		})
+	}
+
+	t.Run("lookups by ID", func(t *testing.T) {
+		exists, err := client.ValidateVPC(ctx, "r006-4727d842-f94f-4a2d-824a-9bc9b02c523b")
+		if err != nil || !exists {
+			t.Errorf("ValidateVPC() = %t, %v, want true", exists, err)
+		}
+		exists, err = client.ValidateVPC(ctx, "r006-00000000-0000-4000-8000-000000000000")
+		if err != nil || exists {
+			t.Errorf("ValidateVPC() = %t, %v, want false", exists, err)
+		}
+
+		subnet, err := client.GetSubnet(ctx, "0717-7931845c-65c4-4b0a-80cd-7d9c1a6d7930")
+		if err != nil || subnet == nil || *subnet.Zone.Name != "us-south-1" {
+			t.Errorf("GetSubnet() = %v, %v, want a subnet in us-south-1", subnet, err)
+		}
+		subnet, err = client.GetSubnet(ctx, "0717-00000000-0000-4000-8000-000000000000")
+		if err != nil || subnet != nil {
+			t.Errorf("GetSubnet() = %v, %v, want nil", subnet, err)
+		}
+
+		key, err := client.GetSSHKey(ctx, "r006-0f1e2d3c-4b5a-4697-8877-665544332211")
+		if err != nil || key == nil || *key.Type != "ed25519" {
+			t.Errorf("GetSSHKey() = %v, %v, want an ed25519 key", key, err)
+		}
+		key, err = client.GetSSHKey(ctx, "r006-00000000-0000-4000-8000-000000000000")
+		if err != nil || key != nil {
+			t.Errorf("GetSSHKey() = %v, %v, want nil", key, err)
+		}
+	})
+}
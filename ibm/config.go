@@ -1,7 +1,46 @@
 package ibm
 
+import (
+	"fmt"
+	"time"
+)
+
 // Config is the configuration for the IBM ruleset.
 type Config struct {
+	DeepCheck      bool   `hclext:"deep_check,optional"`
 	IBMCloudApiKey string `hclext:"ibmcloud_api_key,optional"`
 	Region         string `hclext:"region,optional"`
+	CacheTTL       string `hclext:"cache_ttl,optional"`
+	VPCEndpoint    string `hclext:"vpc_endpoint,optional"`
+	IAMEndpoint    string `hclext:"iam_endpoint,optional"`
+	PageSize       int    `hclext:"page_size,optional"`
+	MaxPages       int    `hclext:"max_pages,optional"`
+
+	// RequiredTags are the tag keys every taggable resource must have, e.g. "env"
+	RequiredTags []string `hclext:"required_tags,optional"`
+}
+
+// cacheTTL returns how long API responses are persisted on disk.
+// Zero means the on-disk cache is disabled.
+func (c *Config) cacheTTL() (time.Duration, error) {
+	if c.CacheTTL == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(c.CacheTTL)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cache_ttl: %w", err)
+	}
+	return ttl, nil
+}
+
+// pagination returns the page size and the maximum number of pages of
+// collection lookups. Zero values fall back to the client defaults.
+func (c *Config) pagination() (int64, int, error) {
+	if c.PageSize < 0 || c.PageSize > DefaultPageSize {
+		return 0, 0, fmt.Errorf("invalid page_size: must be between 1 and %d", DefaultPageSize)
+	}
+	if c.MaxPages < 0 {
+		return 0, 0, fmt.Errorf("invalid max_pages: must be a positive number")
+	}
+	return int64(c.PageSize), c.MaxPages, nil
 }
@@ -0,0 +1,136 @@
+package ibm
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultRegion is the region used when none is configured, matching the ibm provider.
+const DefaultRegion = "us-south"
+
+// defaultCRTokenFilenames are the compute resource token files mounted into
+// containers, in the order the IBM Cloud SDK looks for them.
+var defaultCRTokenFilenames = []string{
+	"/var/run/secrets/tokens/vault-token",
+	"/var/run/secrets/tokens/sa-token",
+}
+
+// CredentialsFromEnv reads credentials from the environment variables supported by the ibm provider.
+func CredentialsFromEnv() Credentials {
+	return Credentials{
+		APIKey:          firstEnv("IC_API_KEY", "IBMCLOUD_API_KEY"),
+		Region:          firstEnv("IC_REGION", "IBMCLOUD_REGION"),
+		Zone:            firstEnv("IC_ZONE", "IBMCLOUD_ZONE"),
+		IAMAccessToken:  firstEnv("IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN"),
+		IAMRefreshToken: firstEnv("IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN"),
+		IAMProfileID:    firstEnv("IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID"),
+		IAMProfileName:  firstEnv("IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME"),
+	}
+}
+
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// MergeCredentials combines credential sources in order of precedence.
+// Authentication settings are taken as a unit from the first source that has any,
+// so an API key from one source is never mixed with a token from another.
+// Region and zone are taken from the first source that sets them.
+func MergeCredentials(sources ...Credentials) Credentials {
+	var merged Credentials
+	authenticated := false
+
+	for _, src := range sources {
+		if !authenticated && src.hasAuth() {
+			merged.APIKey = src.APIKey
+			merged.IAMAccessToken = src.IAMAccessToken
+			merged.IAMRefreshToken = src.IAMRefreshToken
+			merged.IAMProfileID = src.IAMProfileID
+			merged.IAMProfileName = src.IAMProfileName
+			merged.CRTokenFilename = src.CRTokenFilename
+			authenticated = true
+		}
+		if merged.Region == "" {
+			merged.Region = src.Region
+		}
+		if merged.Zone == "" {
+			merged.Zone = src.Zone
+		}
+	}
+
+	if merged.Region == "" {
+		merged.Region = DefaultRegion
+	}
+	return merged
+}
+
+func (c Credentials) hasAuth() bool {
+	return c.APIKey != "" || c.IAMAccessToken != "" || c.IAMRefreshToken != "" || c.IAMProfileID != "" || c.IAMProfileName != ""
+}
+
+// authenticator returns an authenticator for the configured authentication method.
+// The order of precedence is: IAM access token, IAM refresh token, API key,
+// and finally a trusted profile with a compute resource token.
+func (c Credentials) authenticator() (core.Authenticator, error) {
+	switch {
+	case c.IAMAccessToken != "":
+		return &core.BearerTokenAuthenticator{
+			BearerToken: strings.TrimPrefix(c.IAMAccessToken, "Bearer "),
+		}, nil
+
+	case c.IAMRefreshToken != "":
+		// The refresh token grant requires the same client as the one that issued
+		// the token, which is "bx" for tokens obtained through the ibmcloud CLI.
+		return &core.IamAuthenticator{
+			RefreshToken: c.IAMRefreshToken,
+			ClientId:     "bx",
+			ClientSecret: "bx",
+			URL:          c.IAMEndpoint,
+			Client:       c.HTTPClient,
+		}, nil
+
+	case c.APIKey != "":
+		return &core.IamAuthenticator{
+			ApiKey: c.APIKey,
+			URL:    c.IAMEndpoint,
+			Client: c.HTTPClient,
+		}, nil
+
+	case c.IAMProfileID != "" || c.IAMProfileName != "":
+		if c.CRTokenFilename != "" || fileExists(defaultCRTokenFilenames...) {
+			return &core.ContainerAuthenticator{
+				CRTokenFilename: c.CRTokenFilename,
+				IAMProfileID:    c.IAMProfileID,
+				IAMProfileName:  c.IAMProfileName,
+				URL:             c.IAMEndpoint,
+				Client:          c.HTTPClient,
+			}, nil
+		}
+		if c.IAMProfileName != "" && c.IAMProfileID == "" {
+			return nil, fmt.Errorf("iam_profile_name requires a compute resource token file; use iam_profile_id on VPC instances")
+		}
+		return &core.VpcInstanceAuthenticator{
+			IAMProfileID: c.IAMProfileID,
+			Client:       c.HTTPClient,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no IBM Cloud credentials found; set ibmcloud_api_key, iam_token or iam_profile_id")
+}
+
+func fileExists(paths ...string) bool {
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package ibm
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/google/go-cmp/cmp"
+)
+
+var credentialEnvs = []string{
+	"IC_API_KEY", "IBMCLOUD_API_KEY",
+	"IC_REGION", "IBMCLOUD_REGION",
+	"IC_ZONE", "IBMCLOUD_ZONE",
+	"IC_IAM_TOKEN", "IBMCLOUD_IAM_TOKEN",
+	"IC_IAM_REFRESH_TOKEN", "IBMCLOUD_IAM_REFRESH_TOKEN",
+	"IC_IAM_PROFILE_ID", "IBMCLOUD_IAM_PROFILE_ID",
+	"IC_IAM_PROFILE_NAME", "IBMCLOUD_IAM_PROFILE_NAME",
+}
+
+func TestCredentialsFromEnv(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want Credentials
+	}{
+		{
+			name: "empty",
+			env:  map[string]string{},
+			want: Credentials{},
+		},
+		{
+			name: "ibmcloud",
+			env: map[string]string{
+				"IBMCLOUD_API_KEY":           "api-key",
+				"IBMCLOUD_REGION":            "eu-de",
+				"IBMCLOUD_ZONE":              "eu-de-1",
+				"IBMCLOUD_IAM_TOKEN":         "access-token",
+				"IBMCLOUD_IAM_REFRESH_TOKEN": "refresh-token",
+				"IBMCLOUD_IAM_PROFILE_ID":    "profile-id",
+				"IBMCLOUD_IAM_PROFILE_NAME":  "profile-name",
+			},
+			want: Credentials{
+				APIKey:          "api-key",
+				Region:          "eu-de",
+				Zone:            "eu-de-1",
+				IAMAccessToken:  "access-token",
+				IAMRefreshToken: "refresh-token",
+				IAMProfileID:    "profile-id",
+				IAMProfileName:  "profile-name",
+			},
+		},
+		{
+			name: "ic takes precedence",
+			env: map[string]string{
+				"IC_API_KEY":       "ic-api-key",
+				"IBMCLOUD_API_KEY": "ibmcloud-api-key",
+				"IC_REGION":        "jp-tok",
+				"IBMCLOUD_REGION":  "eu-de",
+			},
+			want: Credentials{APIKey: "ic-api-key", Region: "jp-tok"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, name := range credentialEnvs {
+				t.Setenv(name, test.env[name])
+			}
+			if diff := cmp.Diff(test.want, CredentialsFromEnv()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestMergeCredentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Credentials
+		provider Credentials
+		env      Credentials
+		want     Credentials
+	}{
+		{
+			name: "default region",
+			want: Credentials{Region: DefaultRegion},
+		},
+		{
+			name: "env",
+			env:  Credentials{APIKey: "env-key", Region: "eu-de", Zone: "eu-de-1"},
+			want: Credentials{APIKey: "env-key", Region: "eu-de", Zone: "eu-de-1"},
+		},
+		{
+			name:     "provider over env",
+			provider: Credentials{APIKey: "provider-key", Region: "jp-tok"},
+			env:      Credentials{APIKey: "env-key", Region: "eu-de", Zone: "eu-de-1"},
+			want:     Credentials{APIKey: "provider-key", Region: "jp-tok", Zone: "eu-de-1"},
+		},
+		{
+			name:     "config over provider",
+			config:   Credentials{APIKey: "config-key", Region: "us-east"},
+			provider: Credentials{APIKey: "provider-key", Region: "jp-tok", Zone: "jp-tok-1"},
+			env:      Credentials{APIKey: "env-key", Region: "eu-de"},
+			want:     Credentials{APIKey: "config-key", Region: "us-east", Zone: "jp-tok-1"},
+		},
+		{
+			name:     "region without authentication",
+			config:   Credentials{Region: "us-east"},
+			provider: Credentials{APIKey: "provider-key", Region: "jp-tok"},
+			want:     Credentials{APIKey: "provider-key", Region: "us-east"},
+		},
+		{
+			name:     "authentication is not mixed across sources",
+			provider: Credentials{IAMAccessToken: "provider-token"},
+			env:      Credentials{APIKey: "env-key", IAMProfileID: "env-profile"},
+			want:     Credentials{IAMAccessToken: "provider-token", Region: DefaultRegion},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MergeCredentials(test.config, test.provider, test.env)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestCredentials_authenticator(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "sa-token")
+
+	tests := []struct {
+		name  string
+		creds Credentials
+		want  string
+	}{
+		{
+			name:  "access token",
+			creds: Credentials{IAMAccessToken: "Bearer token", IAMRefreshToken: "refresh-token", APIKey: "api-key", IAMProfileID: "profile-id"},
+			want:  core.AUTHTYPE_BEARER_TOKEN,
+		},
+		{
+			name:  "refresh token",
+			creds: Credentials{IAMRefreshToken: "refresh-token", APIKey: "api-key", IAMProfileID: "profile-id"},
+			want:  core.AUTHTYPE_IAM,
+		},
+		{
+			name:  "api key",
+			creds: Credentials{APIKey: "api-key", IAMProfileID: "profile-id"},
+			want:  core.AUTHTYPE_IAM,
+		},
+		{
+			name:  "trusted profile with a compute resource token",
+			creds: Credentials{IAMProfileName: "profile-name", CRTokenFilename: tokenFile},
+			want:  core.AUTHTYPE_CONTAINER,
+		},
+		{
+			name:  "trusted profile on a VPC instance",
+			creds: Credentials{IAMProfileID: "profile-id"},
+			want:  core.AUTHTYPE_VPC,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.want == core.AUTHTYPE_VPC && fileExists(defaultCRTokenFilenames...) {
+				t.Skip("a compute resource token file is mounted")
+			}
+			authenticator, err := test.creds.authenticator()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := authenticator.AuthenticationType(); got != test.want {
+				t.Errorf("authentication type = %s, want %s", got, test.want)
+			}
+
+			switch a := authenticator.(type) {
+			case *core.BearerTokenAuthenticator:
+				if a.BearerToken != "token" {
+					t.Errorf("bearer token = %s, want the token without its prefix", a.BearerToken)
+				}
+			case *core.IamAuthenticator:
+				// A refresh token is used on its own, without the API key
+				if a.RefreshToken != test.creds.IAMRefreshToken {
+					t.Errorf("refresh token = %q, want %q", a.RefreshToken, test.creds.IAMRefreshToken)
+				}
+				if a.RefreshToken == "" && a.ApiKey != test.creds.APIKey {
+					t.Errorf("api key = %q, want %q", a.ApiKey, test.creds.APIKey)
+				}
+			}
+		})
+	}
+}
+
+func TestCredentials_authenticatorErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		creds Credentials
+	}{
+		{name: "no credentials", creds: Credentials{Region: "us-south"}},
+		{name: "profile name without a token file", creds: Credentials{IAMProfileName: "profile-name"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if fileExists(defaultCRTokenFilenames...) {
+				t.Skip("a compute resource token file is mounted")
+			}
+			if _, err := test.creds.authenticator(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -101,6 +101,36 @@ func DecodeProviderConfigRef(expr hcl.Expression, argName string) (*ProviderConf
 	return ret, diags
 }
 
+// NormalizeProviderConfigRef returns the canonical source of a provider
+// configuration reference, e.g. `ibm.eu` for `"ibm.eu"` or `IBM.eu`, so that
+// quoted references and unnormalized provider names can be fixed automatically.
+// It returns false when the reference is already canonical or is invalid.
+func NormalizeProviderConfigRef(expr hcl.Expression) (string, bool) {
+	shimmed, _ := shimTraversalInString(expr, false)
+	traversal, diags := hcl.AbsTraversalForExpr(shimmed)
+	if diags.HasErrors() || len(traversal) < 1 || len(traversal) > 2 {
+		return "", false
+	}
+
+	name, err := ParseProviderPart(traversal.RootName())
+	if err != nil {
+		return "", false
+	}
+	ref := name
+	if len(traversal) > 1 {
+		alias, ok := traversal[1].(hcl.TraverseAttr)
+		if !ok {
+			return "", false
+		}
+		ref += "." + alias.Name
+	}
+
+	if !exprIsNativeQuotedString(expr) && name == traversal.RootName() {
+		return "", false
+	}
+	return ref, true
+}
+
 // original code: https://github.com/hashicorp/terraform/blob/3fbedf25430ead97eb42575d344427db3c32d524/internal/configs/compat_shim.go#L21-L92
 // shimTraversalInString takes any arbitrary expression and checks if it is
 // a quoted string in the native syntax. If it _is_, then it is parsed as a
@@ -0,0 +1,224 @@
+// Code generated by tools/fake-gen from the Client interface; DO NOT EDIT.
+
+package ibm
+
+import (
+	"context"
+	"sync"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// FakeClient is an in-memory Client for rule tests.
+// Each method calls the field of the same name with a Func suffix, and
+// returns zero values when it is nil. Calls are recorded in order.
+type FakeClient struct {
+	RegionFunc                  func() string
+	GetInstanceProfilesFunc     func(ctx context.Context) (map[string]bool, error)
+	GetImagesFunc               func(ctx context.Context) (map[string]bool, error)
+	GetVPCFunc                  func(ctx context.Context, id string) (*vpcv1.VPC, error)
+	ValidateVPCFunc             func(ctx context.Context, vpcID string) (bool, error)
+	GetBackupPoliciesFunc       func(ctx context.Context) (map[string]bool, error)
+	ValidateBackupPolicyFunc    func(ctx context.Context, policyID string) (bool, error)
+	GetSubnetsFunc              func(ctx context.Context) (map[string]bool, error)
+	GetSubnetFunc               func(ctx context.Context, id string) (*vpcv1.Subnet, error)
+	GetSecurityGroupsFunc       func(ctx context.Context) (map[string]bool, error)
+	GetSSHKeysFunc              func(ctx context.Context) (map[string]bool, error)
+	GetSSHKeyFunc               func(ctx context.Context, id string) (*vpcv1.Key, error)
+	GetVolumesFunc              func(ctx context.Context) (map[string]bool, error)
+	GetVolumeProfilesFunc       func(ctx context.Context) (map[string]bool, error)
+	GetBareMetalProfilesFunc    func(ctx context.Context) (map[string]bool, error)
+	GetPublicGatewaysFunc       func(ctx context.Context) (map[string]bool, error)
+	GetLoadBalancerProfilesFunc func(ctx context.Context) (map[string]bool, error)
+	GetDedicatedHostsFunc       func(ctx context.Context) (map[string]bool, error)
+	GetPlacementGroupsFunc      func(ctx context.Context) (map[string]bool, error)
+
+	mu    sync.Mutex
+	calls []string
+}
+
+var _ Client = (*FakeClient)(nil)
+
+// Region calls RegionFunc.
+func (f *FakeClient) Region() string {
+	f.record("Region")
+	if f.RegionFunc == nil {
+		return ""
+	}
+	return f.RegionFunc()
+}
+
+// GetInstanceProfiles calls GetInstanceProfilesFunc.
+func (f *FakeClient) GetInstanceProfiles(ctx context.Context) (map[string]bool, error) {
+	f.record("GetInstanceProfiles")
+	if f.GetInstanceProfilesFunc == nil {
+		return nil, nil
+	}
+	return f.GetInstanceProfilesFunc(ctx)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *FakeClient) GetImages(ctx context.Context) (map[string]bool, error) {
+	f.record("GetImages")
+	if f.GetImagesFunc == nil {
+		return nil, nil
+	}
+	return f.GetImagesFunc(ctx)
+}
+
+// GetVPC calls GetVPCFunc.
+func (f *FakeClient) GetVPC(ctx context.Context, id string) (*vpcv1.VPC, error) {
+	f.record("GetVPC")
+	if f.GetVPCFunc == nil {
+		return nil, nil
+	}
+	return f.GetVPCFunc(ctx, id)
+}
+
+// ValidateVPC calls ValidateVPCFunc.
+func (f *FakeClient) ValidateVPC(ctx context.Context, vpcID string) (bool, error) {
+	f.record("ValidateVPC")
+	if f.ValidateVPCFunc == nil {
+		return false, nil
+	}
+	return f.ValidateVPCFunc(ctx, vpcID)
+}
+
+// GetBackupPolicies calls GetBackupPoliciesFunc.
+func (f *FakeClient) GetBackupPolicies(ctx context.Context) (map[string]bool, error) {
+	f.record("GetBackupPolicies")
+	if f.GetBackupPoliciesFunc == nil {
+		return nil, nil
+	}
+	return f.GetBackupPoliciesFunc(ctx)
+}
+
+// ValidateBackupPolicy calls ValidateBackupPolicyFunc.
+func (f *FakeClient) ValidateBackupPolicy(ctx context.Context, policyID string) (bool, error) {
+	f.record("ValidateBackupPolicy")
+	if f.ValidateBackupPolicyFunc == nil {
+		return false, nil
+	}
+	return f.ValidateBackupPolicyFunc(ctx, policyID)
+}
+
+// GetSubnets calls GetSubnetsFunc.
+func (f *FakeClient) GetSubnets(ctx context.Context) (map[string]bool, error) {
+	f.record("GetSubnets")
+	if f.GetSubnetsFunc == nil {
+		return nil, nil
+	}
+	return f.GetSubnetsFunc(ctx)
+}
+
+// GetSubnet calls GetSubnetFunc.
+func (f *FakeClient) GetSubnet(ctx context.Context, id string) (*vpcv1.Subnet, error) {
+	f.record("GetSubnet")
+	if f.GetSubnetFunc == nil {
+		return nil, nil
+	}
+	return f.GetSubnetFunc(ctx, id)
+}
+
+// GetSecurityGroups calls GetSecurityGroupsFunc.
+func (f *FakeClient) GetSecurityGroups(ctx context.Context) (map[string]bool, error) {
+	f.record("GetSecurityGroups")
+	if f.GetSecurityGroupsFunc == nil {
+		return nil, nil
+	}
+	return f.GetSecurityGroupsFunc(ctx)
+}
+
+// GetSSHKeys calls GetSSHKeysFunc.
+func (f *FakeClient) GetSSHKeys(ctx context.Context) (map[string]bool, error) {
+	f.record("GetSSHKeys")
+	if f.GetSSHKeysFunc == nil {
+		return nil, nil
+	}
+	return f.GetSSHKeysFunc(ctx)
+}
+
+// GetSSHKey calls GetSSHKeyFunc.
+func (f *FakeClient) GetSSHKey(ctx context.Context, id string) (*vpcv1.Key, error) {
+	f.record("GetSSHKey")
+	if f.GetSSHKeyFunc == nil {
+		return nil, nil
+	}
+	return f.GetSSHKeyFunc(ctx, id)
+}
+
+// GetVolumes calls GetVolumesFunc.
+func (f *FakeClient) GetVolumes(ctx context.Context) (map[string]bool, error) {
+	f.record("GetVolumes")
+	if f.GetVolumesFunc == nil {
+		return nil, nil
+	}
+	return f.GetVolumesFunc(ctx)
+}
+
+// GetVolumeProfiles calls GetVolumeProfilesFunc.
+func (f *FakeClient) GetVolumeProfiles(ctx context.Context) (map[string]bool, error) {
+	f.record("GetVolumeProfiles")
+	if f.GetVolumeProfilesFunc == nil {
+		return nil, nil
+	}
+	return f.GetVolumeProfilesFunc(ctx)
+}
+
+// GetBareMetalProfiles calls GetBareMetalProfilesFunc.
+func (f *FakeClient) GetBareMetalProfiles(ctx context.Context) (map[string]bool, error) {
+	f.record("GetBareMetalProfiles")
+	if f.GetBareMetalProfilesFunc == nil {
+		return nil, nil
+	}
+	return f.GetBareMetalProfilesFunc(ctx)
+}
+
+// GetPublicGateways calls GetPublicGatewaysFunc.
+func (f *FakeClient) GetPublicGateways(ctx context.Context) (map[string]bool, error) {
+	f.record("GetPublicGateways")
+	if f.GetPublicGatewaysFunc == nil {
+		return nil, nil
+	}
+	return f.GetPublicGatewaysFunc(ctx)
+}
+
+// GetLoadBalancerProfiles calls GetLoadBalancerProfilesFunc.
+func (f *FakeClient) GetLoadBalancerProfiles(ctx context.Context) (map[string]bool, error) {
+	f.record("GetLoadBalancerProfiles")
+	if f.GetLoadBalancerProfilesFunc == nil {
+		return nil, nil
+	}
+	return f.GetLoadBalancerProfilesFunc(ctx)
+}
+
+// GetDedicatedHosts calls GetDedicatedHostsFunc.
+func (f *FakeClient) GetDedicatedHosts(ctx context.Context) (map[string]bool, error) {
+	f.record("GetDedicatedHosts")
+	if f.GetDedicatedHostsFunc == nil {
+		return nil, nil
+	}
+	return f.GetDedicatedHostsFunc(ctx)
+}
+
+// GetPlacementGroups calls GetPlacementGroupsFunc.
+func (f *FakeClient) GetPlacementGroups(ctx context.Context) (map[string]bool, error) {
+	f.record("GetPlacementGroups")
+	if f.GetPlacementGroupsFunc == nil {
+		return nil, nil
+	}
+	return f.GetPlacementGroupsFunc(ctx)
+}
+
+// Calls returns the names of the methods called so far, in order.
+func (f *FakeClient) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.calls...)
+}
+
+func (f *FakeClient) record(method string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, method)
+}
@@ -0,0 +1,252 @@
+package ibmtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+)
+
+// Mode selects whether a Recorder talks to the real API or replays a cassette.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and never touches the network.
+	ModeReplay Mode = iota
+	// ModeRecord forwards requests to the real API and saves the responses.
+	ModeRecord
+)
+
+// RecordEnv is the environment variable that switches recorders to ModeRecord.
+const RecordEnv = "IBMTEST_RECORD"
+
+// ModeFromEnv returns ModeRecord when IBMTEST_RECORD is set, and ModeReplay otherwise.
+// Recording requires IBMCLOUD_API_KEY to be set as well.
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) != "" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// Cassette is a recorded sequence of HTTP interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+// Request bodies are never stored, as they contain credentials.
+type Interaction struct {
+	Method   string           `json:"method"`
+	URL      string           `json:"url"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedResponse is a scrubbed HTTP response.
+type RecordedResponse struct {
+	StatusCode  int             `json:"status_code"`
+	ContentType string          `json:"content_type"`
+	Body        json.RawMessage `json:"body"`
+}
+
+var (
+	// tokenPattern matches IAM tokens in token responses
+	tokenPattern = regexp.MustCompile(`"(access_token|refresh_token|delegated_refresh_token)"\s*:\s*"[^"]*"`)
+	// accountPattern matches account IDs in CRNs
+	accountPattern = regexp.MustCompile(`:a/[0-9a-f]{32}:`)
+	// hostPattern matches the scheme and host of links to other resources and pages
+	hostPattern = regexp.MustCompile(`"(href|next)"\s*:\s*"https?://[^/"]+`)
+)
+
+// ScrubbedHost replaces the API host in the links of recorded responses.
+const ScrubbedHost = "https://api.ibmtest.invalid"
+
+// Recorder is an http.RoundTripper that records interactions to a cassette
+// file or replays them from it.
+type Recorder struct {
+	mode      Mode
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder returns a recorder for the cassette at the given path.
+// In replay mode, the cassette must exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		transport: http.DefaultTransport,
+	}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	if err := json.Unmarshal(src, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// Client returns an HTTP client that sends requests through the recorder.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Credentials returns client credentials that route all requests through the recorder.
+// In record mode the API key is read from IBMCLOUD_API_KEY.
+func (r *Recorder) Credentials(region string) ibm.Credentials {
+	apiKey := APIKey
+	if r.mode == ModeRecord {
+		apiKey = os.Getenv("IBMCLOUD_API_KEY")
+	}
+	return ibm.Credentials{
+		APIKey:     apiKey,
+		Region:     region,
+		HTTPClient: r.Client(),
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Response: RecordedResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        scrub(body),
+		},
+	})
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// replay returns the first unused interaction matching the request method and URL.
+// Once all matching interactions are used, the last one is served again, so
+// repeated requests such as token refreshes do not need to be recorded twice.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := matchKey(req.URL.String())
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Method != req.Method || matchKey(interaction.URL) != key {
+			continue
+		}
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("cassette %s has no interaction for %s %s", r.path, req.Method, req.URL)
+	}
+	r.used[match] = true
+
+	recorded := r.cassette.Interactions[match].Response
+	body := []byte(recorded.Body)
+	var text string
+	if !strings.Contains(recorded.ContentType, "json") && json.Unmarshal(body, &text) == nil {
+		body = []byte(text)
+	}
+	header := http.Header{}
+	if recorded.ContentType != "" {
+		header.Set("Content-Type", recorded.ContentType)
+	}
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode: recorded.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// matchKey returns the URL without the `version` query parameter, which the
+// SDK sets to its API version date, so that SDK upgrades keep cassettes valid.
+func matchKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := u.Query()
+	query.Del("version")
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// Save writes the recorded interactions to the cassette file.
+// It does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	src, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(src, '\n'), 0o644)
+}
+
+// scrub removes secrets and hosts from a response body. Non-JSON bodies are stored as JSON strings.
+func scrub(body []byte) json.RawMessage {
+	body = tokenPattern.ReplaceAll(body, []byte(`"$1":"REDACTED"`))
+	body = accountPattern.ReplaceAll(body, []byte(`:a/00000000000000000000000000000000:`))
+	body = hostPattern.ReplaceAll(body, []byte(`"$1":"`+ScrubbedHost))
+
+	if json.Valid(body) {
+		return body
+	}
+	quoted, _ := json.Marshal(string(body))
+	return quoted
+}
@@ -0,0 +1,55 @@
+package ibmtest
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestScrub(t *testing.T) {
+	body := `{"access_token":"eyJraWQiOi","crn":"crn:v1:bluemix:public:is:us-south:a/0123456789abcdef0123456789abcdef::vpc:r006-1",` +
+		`"href":"https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-1","next":{"href": "http://127.0.0.1:38787/v1/vpcs?start=2"}}`
+
+	got := string(scrub([]byte(body)))
+	want := `{"access_token":"REDACTED","crn":"crn:v1:bluemix:public:is:us-south:a/00000000000000000000000000000000::vpc:r006-1",` +
+		`"href":"https://api.ibmtest.invalid/v1/vpcs/r006-1","next":{"href":"https://api.ibmtest.invalid/v1/vpcs?start=2"}}`
+	if got != want {
+		t.Errorf("scrub() = %s, want %s", got, want)
+	}
+}
+
+func TestRecorder_replayIgnoresVersion(t *testing.T) {
+	recorder := &Recorder{
+		mode: ModeReplay,
+		path: "cassette.json",
+		cassette: Cassette{Interactions: []Interaction{{
+			Method: http.MethodGet,
+			URL:    "https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-1?generation=2&version=2024-12-17",
+			Response: RecordedResponse{
+				StatusCode:  http.StatusOK,
+				ContentType: "application/json",
+				Body:        []byte(`{"id":"r006-1"}`),
+			},
+		}}},
+		used: make([]bool, 1),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-1?version=2025-06-03&generation=2", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := recorder.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"id":"r006-1"}` {
+		t.Errorf("body = %s", body)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-2?version=2024-12-17&generation=2", nil)
+	if _, err := recorder.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "no interaction") {
+		t.Errorf("error = %v, want a missing interaction", err)
+	}
+}
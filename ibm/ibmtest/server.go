@@ -0,0 +1,253 @@
+// Package ibmtest provides a local stand-in for the IBM Cloud IAM and VPC APIs,
+// so deep check rules can be tested end-to-end without network access.
+//
+// Server serves hand-written JSON fixtures. Recorder records API responses to
+// cassettes with IBMTEST_RECORD=1 and IBMCLOUD_API_KEY set, scrubbing tokens,
+// account IDs and hosts, and replays them otherwise.
+package ibmtest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+)
+
+//go:embed fixtures/default.json
+var defaultFixtures []byte
+
+// APIKey is the only API key accepted by the fake IAM token endpoint.
+const APIKey = "ibmtest-api-key"
+
+// Fixtures are the resources served by the fake VPC API.
+// Each resource is returned verbatim, so fixtures can be copied from real API responses.
+type Fixtures struct {
+	VPCs             []json.RawMessage `json:"vpcs"`
+	Images           []json.RawMessage `json:"images"`
+	InstanceProfiles []json.RawMessage `json:"instance_profiles"`
+	BackupPolicies   []json.RawMessage `json:"backup_policies"`
+	Subnets          []json.RawMessage `json:"subnets"`
+	SecurityGroups   []json.RawMessage `json:"security_groups"`
+	Keys             []json.RawMessage `json:"keys"`
+	Volumes          []json.RawMessage `json:"volumes"`
+	VolumeProfiles   []json.RawMessage `json:"volume_profiles"`
+}
+
+// DefaultFixtures returns the fixtures embedded in this package.
+func DefaultFixtures() *Fixtures {
+	fixtures, err := ParseFixtures(defaultFixtures)
+	if err != nil {
+		panic(fmt.Sprintf("invalid default fixtures: %s", err))
+	}
+	return fixtures
+}
+
+// LoadFixtures reads fixtures from a JSON file.
+func LoadFixtures(path string) (*Fixtures, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFixtures(src)
+}
+
+// ParseFixtures decodes fixtures from JSON.
+func ParseFixtures(src []byte) (*Fixtures, error) {
+	var fixtures Fixtures
+	if err := json.Unmarshal(src, &fixtures); err != nil {
+		return nil, err
+	}
+	return &fixtures, nil
+}
+
+// collection describes how a VPC API collection is served.
+type collection struct {
+	// path is the collection path relative to /v1
+	path string
+	// key is the name of the array in the collection response
+	key string
+	// identifier is the field used to look up a single resource
+	identifier string
+	// paginated reports whether the API pages this collection
+	paginated bool
+	items     func(*Fixtures) []json.RawMessage
+}
+
+var collections = []collection{
+	{path: "vpcs", key: "vpcs", identifier: "id", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.VPCs }},
+	{path: "images", key: "images", identifier: "id", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.Images }},
+	{path: "instance/profiles", key: "profiles", identifier: "name", items: func(f *Fixtures) []json.RawMessage { return f.InstanceProfiles }},
+	{path: "backup_policies", key: "backup_policies", identifier: "id", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.BackupPolicies }},
+	{path: "subnets", key: "subnets", identifier: "id", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.Subnets }},
+	{path: "security_groups", key: "security_groups", identifier: "id", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.SecurityGroups }},
+	{path: "keys", key: "keys", identifier: "id", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.Keys }},
+	{path: "volumes", key: "volumes", identifier: "id", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.Volumes }},
+	{path: "volume/profiles", key: "profiles", identifier: "name", paginated: true, items: func(f *Fixtures) []json.RawMessage { return f.VolumeProfiles }},
+}
+
+// Server is a fake IBM Cloud API server.
+type Server struct {
+	*httptest.Server
+
+	fixtures *Fixtures
+
+	mu       sync.Mutex
+	requests []string
+}
+
+// NewServer starts a fake API server serving the given fixtures.
+// The caller must call Close when finished.
+func NewServer(fixtures *Fixtures) *Server {
+	s := &Server{fixtures: fixtures}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// VPCEndpoint returns the base URL of the fake VPC API.
+func (s *Server) VPCEndpoint() string {
+	return s.URL + "/v1"
+}
+
+// IAMEndpoint returns the base URL of the fake IAM API.
+func (s *Server) IAMEndpoint() string {
+	return s.URL
+}
+
+// Config returns a deep check plugin config pointing at the server.
+func (s *Server) Config(region string) *ibm.Config {
+	return &ibm.Config{
+		DeepCheck:      true,
+		IBMCloudApiKey: APIKey,
+		Region:         region,
+		VPCEndpoint:    s.VPCEndpoint(),
+		IAMEndpoint:    s.IAMEndpoint(),
+	}
+}
+
+// Requests returns the method and path of every request received so far,
+// e.g. "GET /v1/images".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	s.mu.Unlock()
+
+	if r.Method == http.MethodPost && r.URL.Path == "/identity/token" {
+		s.token(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, "/v1/") {
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s is not supported by the fake API", r.Method, r.URL.Path))
+		return
+	}
+	if r.Header.Get("Authorization") == "" {
+		writeError(w, http.StatusUnauthorized, "not_authorized", "missing Authorization header")
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	for _, c := range collections {
+		if path == c.path {
+			s.list(w, r, c)
+			return
+		}
+		if id, ok := strings.CutPrefix(path, c.path+"/"); ok && !strings.Contains(id, "/") {
+			s.get(w, c, id)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s is not supported by the fake API", r.URL.Path))
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "BXNIM0109E", err.Error())
+		return
+	}
+	if r.PostForm.Get("apikey") != APIKey {
+		writeError(w, http.StatusBadRequest, "BXNIM0415E", "Provided API key could not be found.")
+		return
+	}
+
+	now := time.Now().Unix()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  "ibmtest-access-token",
+		"refresh_token": "ibmtest-refresh-token",
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"expiration":    now + 3600,
+	})
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, c collection) {
+	items := c.items(s.fixtures)
+	if items == nil {
+		items = []json.RawMessage{}
+	}
+	body := map[string]interface{}{}
+
+	if c.paginated {
+		limit := 50
+		if v, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && v > 0 {
+			limit = v
+		}
+		start := 0
+		if v, err := strconv.Atoi(r.URL.Query().Get("start")); err == nil && v > 0 {
+			start = min(v, len(items))
+		}
+		end := min(start+limit, len(items))
+
+		body["limit"] = limit
+		body["total_count"] = len(items)
+		body["first"] = map[string]string{"href": fmt.Sprintf("%s/%s?limit=%d", s.VPCEndpoint(), c.path, limit)}
+		if end < len(items) {
+			body["next"] = map[string]string{"href": fmt.Sprintf("%s/%s?limit=%d&start=%d", s.VPCEndpoint(), c.path, limit, end)}
+		}
+		items = items[start:end]
+	}
+	body[c.key] = items
+
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) get(w http.ResponseWriter, c collection, id string) {
+	for _, item := range c.items(s.fixtures) {
+		var fields map[string]interface{}
+		if err := json.Unmarshal(item, &fields); err != nil {
+			writeError(w, http.StatusInternalServerError, "internal_error", err.Error())
+			return
+		}
+		if fields[c.identifier] == id {
+			writeJSON(w, http.StatusOK, item)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s not found", c.path, id))
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code string, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{"code": code, "message": message}},
+		"trace":  "ibmtest",
+	})
+}
@@ -0,0 +1,21 @@
+package ibm
+
+import "regexp"
+
+// resourceIDPattern matches VPC infrastructure resource IDs such as
+// "r006-14140f94-fcc4-11e9-96e7-a72723715315".
+var resourceIDPattern = regexp.MustCompile(`^[a-z0-9]{4}-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// IsResourceID reports whether the given string looks like a VPC resource ID.
+func IsResourceID(id string) bool {
+	return resourceIDPattern.MatchString(id)
+}
+
+// encryptionKeyCRNPattern matches the CRNs of Key Protect and Hyper Protect Crypto Services
+// root keys such as "crn:v1:bluemix:public:kms:us-south:a/<account ID>:<instance ID>:key:<key ID>".
+var encryptionKeyCRNPattern = regexp.MustCompile(`^crn:v1:[a-z]+:(public|private|dedicated):(kms|hs-crypto):[a-z0-9-]+:a/[0-9a-f]{32}:[0-9a-f-]{36}:key:[0-9a-f-]{36}$`)
+
+// IsEncryptionKeyCRN reports whether the given string looks like the CRN of a root key.
+func IsEncryptionKeyCRN(crn string) bool {
+	return encryptionKeyCRNPattern.MatchString(crn)
+}
@@ -0,0 +1,42 @@
+package ibm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	// DefaultPageSize is the number of items requested per page. It is the maximum the VPC API allows.
+	DefaultPageSize = 100
+	// DefaultMaxPages bounds how many pages a single collection lookup may read.
+	DefaultMaxPages = 100
+)
+
+// ErrTooManyPages is returned by collection lookups of collections with more than
+// the maximum number of pages. Callers may fall back to looking up items by ID.
+var ErrTooManyPages = errors.New("too many pages")
+
+// pager is implemented by the collection pagers of the vpc-go-sdk.
+type pager[T any] interface {
+	HasNext() bool
+	GetNextWithContext(ctx context.Context) ([]T, error)
+}
+
+// collectPages walks the `next` links of a collection and merges all pages.
+// It fails rather than returning a truncated collection when maxPages is exceeded,
+// since a partial result would cause false "not found" issues.
+func collectPages[T any](ctx context.Context, p pager[T], maxPages int) ([]T, error) {
+	var items []T
+	for pages := 0; p.HasNext(); pages++ {
+		if pages >= maxPages {
+			return nil, fmt.Errorf("%w: collection has more than %d pages", ErrTooManyPages, maxPages)
+		}
+		page, err := p.GetNextWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+	}
+	return items, nil
+}
@@ -0,0 +1,81 @@
+package ibm_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/ibm/ibmtest"
+)
+
+// imageFixtures returns n images named image-0 to image-<n-1>
+func imageFixtures(n int) *ibmtest.Fixtures {
+	fixtures := &ibmtest.Fixtures{}
+	for i := 0; i < n; i++ {
+		image := fmt.Sprintf(`{"id": "r006-00000000-0000-4000-8000-%012d", "name": "image-%d"}`, i, i)
+		fixtures.Images = append(fixtures.Images, json.RawMessage(image))
+	}
+	return fixtures
+}
+
+func newTestClient(t *testing.T, server *ibmtest.Server, maxPages int) *ibm.IBMClient {
+	t.Helper()
+
+	client, err := ibm.NewClient(ibm.Credentials{
+		APIKey:      ibmtest.APIKey,
+		Region:      "us-south",
+		VPCEndpoint: server.VPCEndpoint(),
+		IAMEndpoint: server.IAMEndpoint(),
+		PageSize:    2,
+		MaxPages:    maxPages,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestGetImages_multiplePages(t *testing.T) {
+	server := ibmtest.NewServer(imageFixtures(5))
+	defer server.Close()
+
+	images, err := newTestClient(t, server, 3).GetImages(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		id := fmt.Sprintf("r006-00000000-0000-4000-8000-%012d", i)
+		name := fmt.Sprintf("image-%d", i)
+		if !images[id] || !images[name] {
+			t.Errorf("image %s (%s) is missing from %v", name, id, images)
+		}
+	}
+
+	pages := 0
+	for _, request := range server.Requests() {
+		if request == "GET /v1/images" {
+			pages++
+		}
+	}
+	if pages != 3 {
+		t.Errorf("got %d page requests, want 3: %v", pages, server.Requests())
+	}
+}
+
+func TestGetImages_tooManyPages(t *testing.T) {
+	server := ibmtest.NewServer(imageFixtures(5))
+	defer server.Close()
+
+	_, err := newTestClient(t, server, 2).GetImages(context.Background())
+	if err == nil {
+		t.Fatal("expected an error for a collection with more than 2 pages")
+	}
+	if !errors.Is(err, ibm.ErrTooManyPages) || !strings.Contains(err.Error(), "more than 2 pages") {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
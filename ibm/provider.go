@@ -8,14 +8,23 @@ import (
 // IBMProviderBlockSchema is a schema of the `ibm` provider block
 var IBMProviderBlockSchema = &hclext.BodySchema{
 	Attributes: []hclext.AttributeSchema{
+		{Name: "alias"},
 		{Name: "ibmcloud_api_key"},
 		{Name: "region"},
-		// Add other attributes as needed (e.g., zone, iam_token, etc.)
+		{Name: "zone"},
+		{Name: "iam_token"},
+		{Name: "iam_refresh_token"},
+		{Name: "iam_profile_id"},
+		{Name: "iam_profile_name"},
 	},
 }
 
-// GetCredentialsFromProvider retrieves credentials from the "provider" block.
-func GetCredentialsFromProvider(runner tflint.Runner) (map[string]Config, error) {
+// DefaultProviderName is the key of the provider configuration without an alias.
+const DefaultProviderName = "ibm"
+
+// GetCredentialsFromProvider retrieves credentials from the "provider" blocks.
+// The result is keyed by alias, with the default provider under DefaultProviderName.
+func GetCredentialsFromProvider(runner tflint.Runner) (map[string]Credentials, error) {
 	providers, err := runner.GetModuleContent(
 		&hclext.BodySchema{
 			Blocks: []hclext.BlockSchema{
@@ -32,38 +41,48 @@ func GetCredentialsFromProvider(runner tflint.Runner) (map[string]Config, error)
 		return nil, err
 	}
 
-	configs := map[string]Config{}
+	credentials := map[string]Credentials{}
 
 	for _, provider := range providers.Blocks {
 		if provider.Labels[0] != "ibm" {
 			continue
 		}
 
-		var config Config
+		var creds Credentials
 		opts := &tflint.EvaluateExprOption{ModuleCtx: tflint.RootModuleCtxType}
 
-		if attr, exists := provider.Body.Attributes["ibmcloud_api_key"]; exists {
-			if err := runner.EvaluateExpr(attr.Expr, func(apiKey string) error {
-				config.IBMCloudApiKey = apiKey
+		// Unknown values, such as variables without defaults, are left empty
+		// so that other credential sources can fill them in.
+		for name, target := range map[string]*string{
+			"ibmcloud_api_key":  &creds.APIKey,
+			"region":            &creds.Region,
+			"zone":              &creds.Zone,
+			"iam_token":         &creds.IAMAccessToken,
+			"iam_refresh_token": &creds.IAMRefreshToken,
+			"iam_profile_id":    &creds.IAMProfileID,
+			"iam_profile_name":  &creds.IAMProfileName,
+		} {
+			attr, exists := provider.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+			if err := runner.EvaluateExpr(attr.Expr, func(val string) error {
+				*target = val
 				return nil
 			}, opts); err != nil {
 				return nil, err
 			}
 		}
 
-		if attr, exists := provider.Body.Attributes["region"]; exists {
-			if err := runner.EvaluateExpr(attr.Expr, func(region string) error {
-				config.Region = region
-				return nil
-			}, opts); err != nil {
+		alias := DefaultProviderName
+		if attr, exists := provider.Body.Attributes["alias"]; exists {
+			if err := runner.EvaluateExpr(attr.Expr, &alias, opts); err != nil {
 				return nil, err
 			}
 		}
 
-		// Add logic to handle aliases if needed
-
-		configs["ibm"] = config // Or use alias if available
+		credentials[alias] = creds
 	}
 
-	return configs, nil
+	return credentials, nil
 }
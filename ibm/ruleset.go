@@ -5,7 +5,6 @@ import (
 
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
-	"github.com/uibm/tflint-ruleset-ibm/rules"
 )
 
 type RuleSet struct {
@@ -13,15 +12,14 @@ type RuleSet struct {
 	config *Config
 }
 
-func NewRuleSet() *RuleSet {
+// NewRuleSet returns the IBM ruleset serving the given rules.
+// Rules are passed in rather than imported so that rules can depend on this package.
+func NewRuleSet(rules []tflint.Rule) *RuleSet {
 	return &RuleSet{
 		BuiltinRuleSet: tflint.BuiltinRuleSet{
 			Name:    "ibm",
 			Version: "0.1.0",
-			Rules: []tflint.Rule{
-				rules.NewIBMIsInstanceRule(),
-				rules.NewIBMIsVPCRule(),
-			},
+			Rules:   rules,
 		},
 	}
 }
@@ -29,8 +27,15 @@ func NewRuleSet() *RuleSet {
 func (r *RuleSet) ConfigSchema() *hclext.BodySchema {
 	return &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
+			{Name: "deep_check", Required: false},
 			{Name: "ibmcloud_api_key", Required: false},
 			{Name: "region", Required: false},
+			{Name: "cache_ttl", Required: false},
+			{Name: "vpc_endpoint", Required: false},
+			{Name: "iam_endpoint", Required: false},
+			{Name: "page_size", Required: false},
+			{Name: "max_pages", Required: false},
+			{Name: "required_tags", Required: false},
 		},
 	}
 }
@@ -45,14 +50,15 @@ func (r *RuleSet) ApplyConfig(body *hclext.BodyContent) error {
 		return fmt.Errorf("failed to decode configuration: %w", diags.Errs()[0])
 	}
 
-	// Validate the configuration
-	if r.config.IBMCloudApiKey == "" {
-		return fmt.Errorf("ibmcloud_api_key is required")
+	if _, err := r.config.cacheTTL(); err != nil {
+		return err
 	}
-	if r.config.Region == "" {
-		return fmt.Errorf("region is required")
+	if _, _, err := r.config.pagination(); err != nil {
+		return err
 	}
 
+	// Credentials are resolved in NewRunner, since they may also come from
+	// the provider block or the environment.
 	return nil
 }
 
@@ -0,0 +1,74 @@
+package ibm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+)
+
+// applyConfig decodes src through the ruleset schema the same way tflint
+// does for the `plugin "ibm"` block.
+func applyConfig(t *testing.T, src string) (*RuleSet, error) {
+	t.Helper()
+
+	file, diags := hclsyntax.ParseConfig([]byte(src), ".tflint.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatal(diags)
+	}
+
+	ruleset := NewRuleSet(nil)
+	content, diags := hclext.Content(file.Body, ruleset.ConfigSchema())
+	if diags.HasErrors() {
+		t.Fatalf("the schema rejects the config: %s", diags)
+	}
+	return ruleset, ruleset.ApplyConfig(content)
+}
+
+func TestApplyConfig(t *testing.T) {
+	ruleset, err := applyConfig(t, `
+deep_check    = true
+region        = "eu-de"
+cache_ttl     = "1h"
+page_size     = 50
+max_pages     = 10
+required_tags = ["env"]
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := ruleset.config
+	if !config.DeepCheck || config.Region != "eu-de" || config.CacheTTL != "1h" {
+		t.Errorf("unexpected config: %+v", config)
+	}
+	if config.PageSize != 50 || config.MaxPages != 10 {
+		t.Errorf("page_size = %d, max_pages = %d, want 50 and 10", config.PageSize, config.MaxPages)
+	}
+	if len(config.RequiredTags) != 1 || config.RequiredTags[0] != "env" {
+		t.Errorf("required_tags = %v, want [env]", config.RequiredTags)
+	}
+}
+
+func TestApplyConfig_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{name: "cache_ttl", src: `cache_ttl = "soon"`, want: "invalid cache_ttl"},
+		{name: "page_size", src: `page_size = 1000`, want: "invalid page_size"},
+		{name: "max_pages", src: `max_pages = -1`, want: "invalid max_pages"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := applyConfig(t, test.src)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("error = %v, want %q", err, test.want)
+			}
+		})
+	}
+}
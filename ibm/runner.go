@@ -1,7 +1,10 @@
 package ibm
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/logger"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
 )
@@ -10,36 +13,218 @@ import (
 type Runner struct {
 	tflint.Runner
 	PluginConfig *Config
-	ibmClient    Client
+	ibmClients   map[string]Client
+	regions      map[string]string
 }
 
 // NewRunner returns a custom IBM Cloud runner.
+// The IBM Cloud clients are only created when deep checking is enabled, so
+// static rules can run without credentials or network access.
+// One client is created for each `provider "ibm"` alias, so resources are
+// checked against the region of the provider configuration they use.
 func NewRunner(runner tflint.Runner, config *Config) (*Runner, error) {
-	var client Client
-	var err error
-
-	if config != nil {
-		// Create a Credentials object from the config
-		creds := Credentials{
-			APIKey: config.IBMCloudApiKey,
-			Region: config.Region,
+	if config == nil {
+		config = &Config{}
+	}
+
+	providers, regions, err := resolveProviders(runner, config)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := map[string]Client{}
+
+	if config.DeepCheck {
+		ttl, err := config.cacheTTL()
+		if err != nil {
+			return nil, err
 		}
-		client, err = NewClient(creds)
+		pageSize, maxPages, err := config.pagination()
 		if err != nil {
 			return nil, err
 		}
+		var cacheDir string
+		if ttl > 0 {
+			cacheDir, err = DefaultCacheDir()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for alias, creds := range providers {
+			creds.VPCEndpoint = config.VPCEndpoint
+			creds.IAMEndpoint = config.IAMEndpoint
+			creds.PageSize = pageSize
+			creds.MaxPages = maxPages
+			if ttl > 0 {
+				creds.CacheDir = cacheDir
+				creds.CacheTTL = ttl
+			}
+
+			client, err := NewClient(creds)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create client for provider %s: %w", providerAddr(alias), err)
+			}
+			clients[alias] = client
+		}
+	}
+
+	return &Runner{
+		Runner:       runner,
+		PluginConfig: config,
+		ibmClients:   clients,
+		regions:      regions,
+	}, nil
+}
+
+// NewRunnerWithClient returns a custom IBM Cloud runner that uses the given
+// client for every `provider "ibm"` alias instead of creating clients from
+// credentials, so deep check rules can be tested against a fake client.
+// Provider regions are resolved in the same way as NewRunner.
+func NewRunnerWithClient(runner tflint.Runner, config *Config, client Client) (*Runner, error) {
+	providers, err := GetCredentialsFromProvider(runner)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := map[string]Client{DefaultProviderName: client}
+	for alias := range providers {
+		clients[alias] = client
+	}
+	return NewRunnerWithClients(runner, config, clients)
+}
+
+// NewRunnerWithClients returns a custom IBM Cloud runner that uses the given
+// client for each `provider "ibm"` alias, keyed by alias with the default
+// provider under DefaultProviderName, so tests can tell which provider
+// configuration a resource is checked against.
+// Provider regions are resolved in the same way as NewRunner.
+func NewRunnerWithClients(runner tflint.Runner, config *Config, clients map[string]Client) (*Runner, error) {
+	if config == nil {
+		config = &Config{}
+	}
+
+	_, regions, err := resolveProviders(runner, config)
+	if err != nil {
+		return nil, err
 	}
 
 	return &Runner{
 		Runner:       runner,
 		PluginConfig: config,
-		ibmClient:    client,
+		ibmClients:   clients,
+		regions:      regions,
 	}, nil
 }
 
-// IBMClient returns the IBM Cloud client.
+// resolveProviders returns the credentials of each `provider "ibm"` alias, and
+// the regions set explicitly by the plugin config, provider blocks or the environment.
+// Aliases whose region falls back to DefaultRegion have no explicit region.
+// The default provider configuration is always present, even without a provider block.
+func resolveProviders(runner tflint.Runner, config *Config) (map[string]Credentials, map[string]string, error) {
+	providers, err := GetCredentialsFromProvider(runner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, exists := providers[DefaultProviderName]; !exists {
+		providers[DefaultProviderName] = Credentials{}
+	}
+
+	env := CredentialsFromEnv()
+	resolved := make(map[string]Credentials, len(providers))
+	regions := map[string]string{}
+	for alias, provider := range providers {
+		// Plugin config takes precedence over the provider block, which takes precedence over the environment
+		creds := MergeCredentials(
+			Credentials{APIKey: config.IBMCloudApiKey, Region: config.Region},
+			provider,
+			env,
+		)
+		// An aliased provider exists to target another region, so its region
+		// wins over the plugin config
+		if alias != DefaultProviderName && provider.Region != "" {
+			creds.Region = provider.Region
+			if provider.Zone != "" {
+				creds.Zone = provider.Zone
+			}
+		}
+		resolved[alias] = creds
+		if config.Region != "" || provider.Region != "" || env.Region != "" {
+			regions[alias] = creds.Region
+		}
+	}
+	return resolved, regions, nil
+}
+
+// NewIBMClient returns the IBM Cloud client of the default provider configuration.
+// It returns nil when deep checking is disabled.
 func (r *Runner) NewIBMClient() Client { // Use IBMClient() as the method name
-	return r.ibmClient
+	return r.ibmClients[DefaultProviderName]
+}
+
+// IBMClientFor returns the IBM Cloud client of the provider configuration
+// selected by the `provider` meta-argument in the given resource attributes.
+// It returns nil when deep checking is disabled, the reference is invalid, or the
+// alias is not declared in the root module, e.g. when it is passed into a child module.
+func (r *Runner) IBMClientFor(attributes hclext.Attributes) (Client, error) {
+	alias, ok := providerAlias(attributes)
+	if !ok {
+		return nil, nil
+	}
+
+	client, exists := r.ibmClients[alias]
+	if !exists {
+		if r.DeepCheck() {
+			logger.Warn("provider %s is not configured in the root module; skipping deep checks", providerAddr(alias))
+		}
+		return nil, nil
+	}
+	return client, nil
+}
+
+// ProviderRegion returns the region of the provider configuration selected by
+// the `provider` meta-argument in the given resource attributes.
+// It is available without deep checking, and returns an empty string when the
+// reference is invalid, the alias is not declared in the root module, or no
+// region is configured and the client would fall back to DefaultRegion.
+func (r *Runner) ProviderRegion(attributes hclext.Attributes) (string, error) {
+	alias, ok := providerAlias(attributes)
+	if !ok {
+		return "", nil
+	}
+	return r.regions[alias], nil
+}
+
+// providerAlias returns the alias of the provider configuration selected by
+// the `provider` meta-argument, or DefaultProviderName when there is none.
+// It returns false for invalid references, which are reported by the
+// `ibm_provider_reference` rule rather than failing every rule that resolves providers.
+func providerAlias(attributes hclext.Attributes) (string, bool) {
+	attr, exists := attributes["provider"]
+	if !exists {
+		return DefaultProviderName, true
+	}
+	ref, diags := DecodeProviderConfigRef(attr.Expr, "provider")
+	if diags.HasErrors() {
+		return "", false
+	}
+	if ref.Alias == "" {
+		return DefaultProviderName, true
+	}
+	return ref.Alias, true
+}
+
+// DeepCheck reports whether rules may call the IBM Cloud API.
+func (r *Runner) DeepCheck() bool {
+	return len(r.ibmClients) > 0
+}
+
+// providerAddr returns the address of a provider configuration, e.g. "ibm.eu".
+func providerAddr(alias string) string {
+	if alias == DefaultProviderName {
+		return DefaultProviderName
+	}
+	return DefaultProviderName + "." + alias
 }
 
 // EachStringSliceExprs iterates an evaluated value and the corresponding expression
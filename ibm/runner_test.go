@@ -0,0 +1,106 @@
+package ibm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+)
+
+const providersConfig = `
+provider "ibm" {
+  region = "us-south"
+  zone   = "us-south-1"
+}
+
+provider "ibm" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibm_is_vpc" "default" {
+  name = "default"
+}
+
+resource "ibm_is_vpc" "eu" {
+  provider = ibm.eu
+  name     = "eu"
+}
+`
+
+func TestRunner_clientPerAlias(t *testing.T) {
+	for _, name := range credentialEnvs {
+		t.Setenv(name, "")
+	}
+
+	clients := map[string]*FakeClient{
+		DefaultProviderName: {RegionFunc: func() string { return "us-south" }},
+		"eu":                {RegionFunc: func() string { return "eu-de" }},
+	}
+	runner, err := NewRunnerWithClients(helper.TestRunner(t, map[string]string{"main.tf": providersConfig}), nil, map[string]Client{
+		DefaultProviderName: clients[DefaultProviderName],
+		"eu":                clients["eu"],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := runner.GetResourceContent("ibm_is_vpc", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}, {Name: "provider"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	regions := map[string]string{}
+	for _, resource := range resources.Blocks {
+		client, err := runner.IBMClientFor(resource.Body.Attributes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.ValidateVPC(context.Background(), resource.Labels[1]); err != nil {
+			t.Fatal(err)
+		}
+		regions[resource.Labels[1]], err = runner.ProviderRegion(resource.Body.Attributes)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for alias, client := range clients {
+		if diff := cmp.Diff([]string{"ValidateVPC"}, client.Calls()); diff != "" {
+			t.Errorf("calls of provider %s: %s", providerAddr(alias), diff)
+		}
+	}
+	if diff := cmp.Diff(map[string]string{"default": "us-south", "eu": "eu-de"}, regions); diff != "" {
+		t.Errorf("regions: %s", diff)
+	}
+}
+
+func TestResolveProviders(t *testing.T) {
+	for _, name := range credentialEnvs {
+		t.Setenv(name, "")
+	}
+	t.Setenv("IC_API_KEY", "env-key")
+	t.Setenv("IC_ZONE", "eu-de-2")
+
+	providers, regions, err := resolveProviders(helper.TestRunner(t, map[string]string{"main.tf": providersConfig}), &Config{Region: "jp-tok"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]Credentials{
+		// The plugin config wins over the default provider block
+		DefaultProviderName: {APIKey: "env-key", Region: "jp-tok", Zone: "us-south-1"},
+		// An aliased provider keeps its own region, and inherits the zone it does not set
+		"eu": {APIKey: "env-key", Region: "eu-de", Zone: "eu-de-2"},
+	}
+	if diff := cmp.Diff(want, providers); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(map[string]string{DefaultProviderName: "jp-tok", "eu": "eu-de"}, regions); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -3,68 +3,238 @@ package ibm
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
+//go:generate go run ../tools/fake-gen -output fake_gen.go
+
 // Client is an interface for the IBM Cloud API client.
+// Collections are keyed by ID and name, or by name for profiles, and are
+// listed at most once per region. FakeClient implements it for rule tests.
 type Client interface {
-	GetInstanceProfiles() (map[string]bool, error)
-	GetImages(region string) (map[string]bool, error)
-	GetVPC(id string) (*vpcv1.VPC, error)
+	Region() string
+	GetInstanceProfiles(ctx context.Context) (map[string]bool, error)
+	GetImages(ctx context.Context) (map[string]bool, error)
+	GetVPC(ctx context.Context, id string) (*vpcv1.VPC, error)
+	ValidateVPC(ctx context.Context, vpcID string) (bool, error)
+	GetBackupPolicies(ctx context.Context) (map[string]bool, error)
+	ValidateBackupPolicy(ctx context.Context, policyID string) (bool, error)
+	GetSubnets(ctx context.Context) (map[string]bool, error)
+	GetSubnet(ctx context.Context, id string) (*vpcv1.Subnet, error)
+	GetSecurityGroups(ctx context.Context) (map[string]bool, error)
+	GetSSHKeys(ctx context.Context) (map[string]bool, error)
+	GetSSHKey(ctx context.Context, id string) (*vpcv1.Key, error)
+	GetVolumes(ctx context.Context) (map[string]bool, error)
+	GetVolumeProfiles(ctx context.Context) (map[string]bool, error)
+	GetBareMetalProfiles(ctx context.Context) (map[string]bool, error)
+	GetPublicGateways(ctx context.Context) (map[string]bool, error)
+	GetLoadBalancerProfiles(ctx context.Context) (map[string]bool, error)
+	GetDedicatedHosts(ctx context.Context) (map[string]bool, error)
+	GetPlacementGroups(ctx context.Context) (map[string]bool, error)
 }
 
+var _ Client = (*IBMClient)(nil)
+
 // GetInstanceProfiles is a wrapper to fetch instance profiles.
-func (c *IBMClient) GetInstanceProfiles() (map[string]bool, error) {
-	profiles := map[string]bool{}
-	options := &vpcv1.ListInstanceProfilesOptions{}
-	result, _, err := c.VPC.ListInstanceProfilesWithContext(context.Background(), options)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list instance profiles: %w", err)
-	}
-	for _, profile := range result.Profiles {
-		profiles[*profile.Name] = true
-	}
-	return profiles, nil
+// Instance profiles are not paginated by the API.
+func (c *IBMClient) GetInstanceProfiles(ctx context.Context) (map[string]bool, error) {
+	return c.cache.get(c.region, "instance_profiles", func() (map[string]bool, error) {
+		profiles := map[string]bool{}
+		options := &vpcv1.ListInstanceProfilesOptions{}
+		result, _, err := c.VPC.ListInstanceProfilesWithContext(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list instance profiles: %w", err)
+		}
+		for _, profile := range result.Profiles {
+			profiles[*profile.Name] = true
+		}
+		return profiles, nil
+	})
 }
 
-// GetImages fetches images available in a specific region.
-func (c *IBMClient) GetImages(region string) (map[string]bool, error) {
-	images := map[string]bool{}
-	options := &vpcv1.ListImagesOptions{}
-	result, _, err := c.VPC.ListImagesWithContext(context.Background(), options)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list images in region %s: %w", region, err)
-	}
-	for _, image := range result.Images {
-		images[*image.Name] = true
-	}
-	return images, nil
+// GetImages fetches images available in the region of the client.
+// The result is keyed by both image ID and image name.
+func (c *IBMClient) GetImages(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "images",
+		func() (pager[vpcv1.Image], error) {
+			return c.VPC.NewImagesPager(&vpcv1.ListImagesOptions{Limit: &c.pageSize})
+		},
+		func(image vpcv1.Image) []*string { return []*string{image.ID, image.Name} },
+	)
 }
 
 // GetVPC is a wrapper to fetch a VPC by ID.
-func (c *IBMClient) GetVPC(id string) (*vpcv1.VPC, error) {
+func (c *IBMClient) GetVPC(ctx context.Context, id string) (*vpcv1.VPC, error) {
 	options := &vpcv1.GetVPCOptions{
 		ID: &id,
 	}
-	vpc, _, err := c.VPC.GetVPCWithContext(context.Background(), options)
+	vpc, _, err := c.VPC.GetVPCWithContext(ctx, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get VPC with ID %s: %w", id, err)
 	}
 	return vpc, nil
 }
 
-// GetBackupPolicies is a wrapper to list Backup policies
-func (c *IBMClient) GetBackupPolicies() (map[string]bool, error) {
-	policies := map[string]bool{}
-	options := &vpcv1.ListBackupPoliciesOptions{}
-	result, _, err := c.VPC.ListBackupPoliciesWithContext(context.Background(), options)
+// GetBackupPolicies is a wrapper to list backup policies.
+// The result is keyed by backup policy ID.
+func (c *IBMClient) GetBackupPolicies(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "backup_policies",
+		func() (pager[vpcv1.BackupPolicyIntf], error) {
+			return c.VPC.NewBackupPoliciesPager(&vpcv1.ListBackupPoliciesOptions{Limit: &c.pageSize})
+		},
+		func(policy vpcv1.BackupPolicyIntf) []*string { return []*string{policy.(*vpcv1.BackupPolicy).ID} },
+	)
+}
+
+// GetSubnets is a wrapper to list subnets.
+func (c *IBMClient) GetSubnets(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "subnets",
+		func() (pager[vpcv1.Subnet], error) {
+			return c.VPC.NewSubnetsPager(&vpcv1.ListSubnetsOptions{Limit: &c.pageSize})
+		},
+		func(subnet vpcv1.Subnet) []*string { return []*string{subnet.ID, subnet.Name} },
+	)
+}
+
+// GetSubnet is a wrapper to fetch a subnet by ID.
+// It returns nil when the subnet does not exist.
+func (c *IBMClient) GetSubnet(ctx context.Context, id string) (*vpcv1.Subnet, error) {
+	subnet, response, err := c.VPC.GetSubnetWithContext(ctx, &vpcv1.GetSubnetOptions{ID: &id})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list backup policies: %w", err)
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get subnet with ID %s: %w", id, err)
 	}
-	for _, policyintf := range result.BackupPolicies {
-		policy := policyintf.(*vpcv1.BackupPolicy)
-		policies[*policy.ID] = true
+	return subnet, nil
+}
+
+// GetSecurityGroups is a wrapper to list security groups.
+func (c *IBMClient) GetSecurityGroups(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "security_groups",
+		func() (pager[vpcv1.SecurityGroup], error) {
+			return c.VPC.NewSecurityGroupsPager(&vpcv1.ListSecurityGroupsOptions{Limit: &c.pageSize})
+		},
+		func(group vpcv1.SecurityGroup) []*string { return []*string{group.ID, group.Name} },
+	)
+}
+
+// GetSSHKeys is a wrapper to list SSH keys.
+func (c *IBMClient) GetSSHKeys(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "keys",
+		func() (pager[vpcv1.Key], error) {
+			return c.VPC.NewKeysPager(&vpcv1.ListKeysOptions{Limit: &c.pageSize})
+		},
+		func(key vpcv1.Key) []*string { return []*string{key.ID, key.Name} },
+	)
+}
+
+// GetSSHKey is a wrapper to fetch an SSH key by ID.
+// It returns nil when the key does not exist.
+func (c *IBMClient) GetSSHKey(ctx context.Context, id string) (*vpcv1.Key, error) {
+	key, response, err := c.VPC.GetKeyWithContext(ctx, &vpcv1.GetKeyOptions{ID: &id})
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get SSH key with ID %s: %w", id, err)
 	}
-	return policies, nil
+	return key, nil
+}
+
+// GetVolumes is a wrapper to list block storage volumes.
+func (c *IBMClient) GetVolumes(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "volumes",
+		func() (pager[vpcv1.Volume], error) {
+			return c.VPC.NewVolumesPager(&vpcv1.ListVolumesOptions{Limit: &c.pageSize})
+		},
+		func(volume vpcv1.Volume) []*string { return []*string{volume.ID, volume.Name} },
+	)
+}
+
+// GetVolumeProfiles is a wrapper to list volume profiles.
+func (c *IBMClient) GetVolumeProfiles(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "volume_profiles",
+		func() (pager[vpcv1.VolumeProfile], error) {
+			return c.VPC.NewVolumeProfilesPager(&vpcv1.ListVolumeProfilesOptions{Limit: &c.pageSize})
+		},
+		func(profile vpcv1.VolumeProfile) []*string { return []*string{profile.Name} },
+	)
+}
+
+// GetBareMetalProfiles is a wrapper to list bare metal server profiles.
+func (c *IBMClient) GetBareMetalProfiles(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "bare_metal_server_profiles",
+		func() (pager[vpcv1.BareMetalServerProfile], error) {
+			return c.VPC.NewBareMetalServerProfilesPager(&vpcv1.ListBareMetalServerProfilesOptions{Limit: &c.pageSize})
+		},
+		func(profile vpcv1.BareMetalServerProfile) []*string { return []*string{profile.Name} },
+	)
+}
+
+// GetPublicGateways is a wrapper to list public gateways.
+func (c *IBMClient) GetPublicGateways(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "public_gateways",
+		func() (pager[vpcv1.PublicGateway], error) {
+			return c.VPC.NewPublicGatewaysPager(&vpcv1.ListPublicGatewaysOptions{Limit: &c.pageSize})
+		},
+		func(gateway vpcv1.PublicGateway) []*string { return []*string{gateway.ID, gateway.Name} },
+	)
+}
+
+// GetLoadBalancerProfiles is a wrapper to list load balancer profiles.
+func (c *IBMClient) GetLoadBalancerProfiles(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "load_balancer_profiles",
+		func() (pager[vpcv1.LoadBalancerProfile], error) {
+			return c.VPC.NewLoadBalancerProfilesPager(&vpcv1.ListLoadBalancerProfilesOptions{Limit: &c.pageSize})
+		},
+		func(profile vpcv1.LoadBalancerProfile) []*string { return []*string{profile.Name} },
+	)
+}
+
+// GetDedicatedHosts is a wrapper to list dedicated hosts.
+func (c *IBMClient) GetDedicatedHosts(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "dedicated_hosts",
+		func() (pager[vpcv1.DedicatedHost], error) {
+			return c.VPC.NewDedicatedHostsPager(&vpcv1.ListDedicatedHostsOptions{Limit: &c.pageSize})
+		},
+		func(host vpcv1.DedicatedHost) []*string { return []*string{host.ID, host.Name} },
+	)
+}
+
+// GetPlacementGroups is a wrapper to list placement groups.
+func (c *IBMClient) GetPlacementGroups(ctx context.Context) (map[string]bool, error) {
+	return listCollection(ctx, c, "placement_groups",
+		func() (pager[vpcv1.PlacementGroup], error) {
+			return c.VPC.NewPlacementGroupsPager(&vpcv1.ListPlacementGroupsOptions{Limit: &c.pageSize})
+		},
+		func(group vpcv1.PlacementGroup) []*string { return []*string{group.ID, group.Name} },
+	)
+}
+
+// listCollection lists all pages of a collection through the cache, and
+// returns the set of keys of its items, such as IDs and names.
+func listCollection[T any](ctx context.Context, c *IBMClient, collection string, newPager func() (pager[T], error), keys func(T) []*string) (map[string]bool, error) {
+	return c.cache.get(c.region, collection, func() (map[string]bool, error) {
+		p, err := newPager()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s in region %s: %w", collection, c.region, err)
+		}
+		result, err := collectPages(ctx, p, c.maxPages)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s in region %s: %w", collection, c.region, err)
+		}
+
+		items := map[string]bool{}
+		for _, item := range result {
+			for _, key := range keys(item) {
+				if key != nil {
+					items[*key] = true
+				}
+			}
+		}
+		return items, nil
+	})
 }
@@ -3,10 +3,11 @@ package main
 import (
 	"github.com/terraform-linters/tflint-plugin-sdk/plugin"
 	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/rules"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
-		RuleSet: ibm.NewRuleSet(),
+		RuleSet: ibm.NewRuleSet(rules.Rules),
 	})
 }
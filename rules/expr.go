@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// referenceKey returns a key identifying the object an expression refers to,
+// so that resources referring to the same VPC, security group, etc. can be
+// grouped. References such as `ibm_is_vpc.example.id` are keyed by their
+// source, and literal strings by their value. It returns an empty string for
+// any other expression.
+func referenceKey(runner tflint.Runner, attr *hclext.Attribute) (string, error) {
+	if traversal, diags := hcl.AbsTraversalForExpr(attr.Expr); !diags.HasErrors() {
+		return traversalString(traversal), nil
+	}
+
+	key, _, err := evaluateString(runner, attr)
+	return key, err
+}
+
+// traversalString formats a traversal as it appears in the source, e.g. `ibm_is_vpc.example[0].id`.
+func traversalString(traversal hcl.Traversal) string {
+	var b strings.Builder
+	for _, step := range traversal {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			b.WriteString(step.Name)
+		case hcl.TraverseAttr:
+			b.WriteString("." + step.Name)
+		case hcl.TraverseIndex:
+			if step.Key.Type() == cty.String {
+				fmt.Fprintf(&b, "[%q]", step.Key.AsString())
+			} else if step.Key.Type() == cty.Number {
+				fmt.Fprintf(&b, "[%s]", step.Key.AsBigFloat().String())
+			}
+		case hcl.TraverseSplat:
+			b.WriteString("[*]")
+		}
+	}
+	return b.String()
+}
+
+// isEvaluable reports whether a reference can be evaluated at lint time, i.e.
+// whether it refers to an input variable or a local value rather than to an
+// attribute of a resource or data source.
+func isEvaluable(traversal hcl.Traversal) bool {
+	switch traversal.RootName() {
+	case "var", "local":
+		return true
+	}
+	return false
+}
+
+// resourceAddress returns the address of a resource block, e.g. `ibm_is_subnet.example`.
+func resourceAddress(resource *hclext.Block) string {
+	return strings.Join(resource.Labels, ".")
+}
+
+// evaluateString evaluates an attribute as a string.
+// It returns false when the value is unknown, null or sensitive.
+func evaluateString(runner tflint.Runner, attr *hclext.Attribute) (string, bool, error) {
+	var (
+		val   string
+		known bool
+	)
+	err := runner.EvaluateExpr(attr.Expr, func(v string) error {
+		val = v
+		known = true
+		return nil
+	}, nil)
+	return val, known, err
+}
+
+// evaluateInt evaluates an attribute as a number.
+// It returns false when the value is unknown, null or sensitive.
+func evaluateInt(runner tflint.Runner, attr *hclext.Attribute) (int, bool, error) {
+	var (
+		val   int
+		known bool
+	)
+	err := runner.EvaluateExpr(attr.Expr, func(v int) error {
+		val = v
+		known = true
+		return nil
+	}, nil)
+	return val, known, err
+}
+
+// isStringLiteral reports whether the expression is a quoted string without interpolations.
+func isStringLiteral(expr hcl.Expression) bool {
+	template, ok := expr.(*hclsyntax.TemplateExpr)
+	return ok && template.IsStringLiteral()
+}
+
+// emitIssueWithStringFix emits an issue that `tflint --fix` fixes by replacing
+// the string literal of expr with value. Other expressions, such as variable
+// references, cannot be fixed safely and are reported without a fix.
+func emitIssueWithStringFix(runner tflint.Runner, rule tflint.Rule, message string, expr hcl.Expression, value string) error {
+	if !isStringLiteral(expr) {
+		return runner.EmitIssue(rule, message, expr.Range())
+	}
+	return runner.EmitIssueWithFix(rule, message, expr.Range(), func(f tflint.Fixer) error {
+		return f.ReplaceText(expr.Range(), f.ValueText(cty.StringVal(value)))
+	})
+}
+
+// emitIssueWithProviderRefFix emits an issue at rng that `tflint --fix` fixes by
+// rewriting the provider configuration reference expr in its canonical form, e.g.
+// `ibm.eu` for `"ibm.eu"` or `IBM.eu`. References that are already canonical or
+// are invalid are reported without a fix.
+func emitIssueWithProviderRefFix(runner tflint.Runner, rule tflint.Rule, message string, rng hcl.Range, expr hcl.Expression) error {
+	normalized, fixable := ibm.NormalizeProviderConfigRef(expr)
+	if !fixable {
+		return runner.EmitIssue(rule, message, rng)
+	}
+	return runner.EmitIssueWithFix(rule, message, rng, func(f tflint.Fixer) error {
+		return f.ReplaceText(expr.Range(), normalized)
+	})
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+)
+
+// imageDataSources returns the image names of `ibm_is_image` data sources by data source name
+func imageDataSources(runner tflint.Runner) (map[string]string, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "data",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "name"}}},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	images := map[string]string{}
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "ibm_is_image" {
+			continue
+		}
+		attr, exists := block.Body.Attributes["name"]
+		if !exists {
+			continue
+		}
+		name, known, err := evaluateString(runner, attr)
+		if err != nil {
+			return nil, err
+		}
+		if known {
+			images[block.Labels[1]] = name
+		}
+	}
+	return images, nil
+}
+
+// instanceImage returns the catalog entry of the image of an instance. The
+// image is known when it is looked up by name with an `ibm_is_image` data
+// source, e.g. `data.ibm_is_image.ubuntu.id`, or given by name.
+func instanceImage(runner tflint.Runner, resource *hclext.Block, images map[string]string) (ibm.Image, bool, error) {
+	attr, exists := resource.Body.Attributes["image"]
+	if !exists {
+		return ibm.Image{}, false, nil
+	}
+
+	if traversal, diags := hcl.AbsTraversalForExpr(attr.Expr); !diags.HasErrors() {
+		if len(traversal) >= 3 && traversal.RootName() == "data" {
+			dataType, _ := traversal[1].(hcl.TraverseAttr)
+			dataName, _ := traversal[2].(hcl.TraverseAttr)
+			if dataType.Name == "ibm_is_image" {
+				image, ok := ibm.LookupImage(images[dataName.Name])
+				return image, ok, nil
+			}
+		}
+		if !isEvaluable(traversal) {
+			return ibm.Image{}, false, nil
+		}
+	}
+
+	name, known, err := evaluateString(runner, attr)
+	if err != nil || !known {
+		return ibm.Image{}, false, err
+	}
+	image, ok := ibm.LookupImage(name)
+	return image, ok, nil
+}
+
+// isWindowsImage reports whether an image runs Windows
+func isWindowsImage(image ibm.Image) bool {
+	return strings.HasPrefix(image.OperatingSystem, "windows")
+}
@@ -0,0 +1,22 @@
+//go:build integration
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm/ibmtest"
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+// TestDeepCheck_fakeServer runs deep check rules end-to-end, with real clients
+// sending requests to the fake API server
+func TestDeepCheck_fakeServer(t *testing.T) {
+	server := ibmtest.NewServer(ibmtest.DefaultFixtures())
+	defer server.Close()
+
+	ruletest.Run(t, NewIBMIsBackupPolicyRule(),
+		ruletest.WithDir("testdata/ibm_is_backup_policy_deep_check"),
+		ruletest.WithConfig(server.Config("us-south")),
+	)
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+)
+
+// networkInterfaceSchema is the schema of `primary_network_interface` and
+// `network_interfaces` blocks of instances, and of `virtual_network_interface`
+// blocks of network attachments
+var networkInterfaceSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "id"},
+		{Name: "subnet"},
+		{Name: "allow_ip_spoofing"},
+	},
+}
+
+// networkAttachmentSchema is the schema of `primary_network_attachment` and
+// `network_attachments` blocks of instances
+var networkAttachmentSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "name"},
+	},
+	Blocks: []hclext.BlockSchema{
+		{Type: "virtual_network_interface", Body: networkInterfaceSchema},
+	},
+}
+
+// instanceNetworkBlocks are the nested blocks that connect an instance to subnets.
+// An instance uses either network interfaces or network attachments.
+var instanceNetworkBlocks = []hclext.BlockSchema{
+	{Type: "primary_network_interface", Body: networkInterfaceSchema},
+	{Type: "network_interfaces", Body: networkInterfaceSchema},
+	{Type: "primary_network_attachment", Body: networkAttachmentSchema},
+	{Type: "network_attachments", Body: networkAttachmentSchema},
+}
+
+// networkInterface is a network interface of an instance, declared either as a
+// network interface block or as the virtual network interface of a network attachment
+type networkInterface struct {
+	// block is the type of the block declaring the interface, e.g. `primary_network_attachment`
+	block string
+	// body is the content of the interface, or nil for a network attachment without
+	// a `virtual_network_interface` block
+	body     *hclext.BodyContent
+	defRange hcl.Range
+}
+
+// instanceNetworkInterfaces returns the network interfaces of an instance decoded
+// with instanceNetworkBlocks
+func instanceNetworkInterfaces(resource *hclext.Block) []networkInterface {
+	var interfaces []networkInterface
+	for _, block := range resource.Body.Blocks {
+		switch block.Type {
+		case "primary_network_interface", "network_interfaces":
+			interfaces = append(interfaces, networkInterface{block: block.Type, body: block.Body, defRange: block.DefRange})
+		case "primary_network_attachment", "network_attachments":
+			iface := networkInterface{block: block.Type, defRange: block.DefRange}
+			for _, vni := range block.Body.Blocks {
+				iface.body = vni.Body
+				iface.defRange = vni.DefRange
+			}
+			interfaces = append(interfaces, iface)
+		}
+	}
+	return interfaces
+}
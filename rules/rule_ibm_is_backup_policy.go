@@ -0,0 +1,170 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// IBMIsBackupPolicyRule checks backup policies and references to existing backup policies
+type IBMIsBackupPolicyRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMIsBackupPolicyRule returns a new rule
+func NewIBMIsBackupPolicyRule() *IBMIsBackupPolicyRule {
+	return &IBMIsBackupPolicyRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsBackupPolicyRule) Name() string {
+	return "ibm_is_backup_policy"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsBackupPolicyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsBackupPolicyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMIsBackupPolicyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsBackupPolicyRule) Metadata() interface{} {
+	return Metadata{Category: "Backup Policy", DeepCheck: DeepCheckOptional}
+}
+
+// Check checks `match_user_tags` of every backup policy, and in deep check mode
+// that literal backup policy IDs exist
+func (r *IBMIsBackupPolicyRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("ibm_is_backup_policy", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "match_user_tags"}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, exists := resource.Body.Attributes["match_user_tags"]
+		if !exists {
+			runner.EmitIssue(
+				r,
+				"`match_user_tags` must be specified; the policy only backs up resources with one of these tags",
+				resource.DefRange,
+			)
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attr.Expr, func(tags []string) error {
+			if len(tags) == 0 {
+				runner.EmitIssue(
+					r,
+					"`match_user_tags` must not be empty; the policy only backs up resources with one of these tags",
+					attr.Expr.Range(),
+				)
+			}
+			return nil
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return r.checkReferences(runner)
+}
+
+// checkReferences checks that literal backup policy IDs referenced by resources
+// and data sources, such as `backup_policy_id` of plans, exist in the provider region
+func (r *IBMIsBackupPolicyRule) checkReferences(runner tflint.Runner) error {
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "backup_policy_id"},
+			{Name: "identifier"},
+			providerAttribute,
+		},
+	}
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: schema},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: schema},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// unlisted holds the clients whose backup policies are too many to list
+	unlisted := map[ibm.Client]bool{}
+
+	for _, block := range content.Blocks {
+		if !strings.HasPrefix(block.Labels[0], "ibm_") {
+			continue
+		}
+		attr, exists := block.Body.Attributes["backup_policy_id"]
+		if block.Type == "data" && block.Labels[0] == "ibm_is_backup_policy" {
+			attr, exists = block.Body.Attributes["identifier"]
+		}
+		if !exists {
+			continue
+		}
+
+		client, err := ibmClient(runner, block)
+		if err != nil {
+			return err
+		}
+		if client == nil {
+			continue
+		}
+
+		if err := runner.EvaluateExpr(attr.Expr, func(id string) error {
+			// References to managed backup policies are unknown at lint time
+			if !ibm.IsResourceID(id) {
+				return nil
+			}
+			exists, err := backupPolicyExists(client, id, unlisted)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("backup policy \"%s\" does not exist in region %s", id, client.Region()),
+					attr.Expr.Range(),
+				)
+			}
+			return nil
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backupPolicyExists reports whether a backup policy exists. Policies are looked
+// up in the cached list of the region, or by ID when the list has too many pages.
+func backupPolicyExists(client ibm.Client, id string, unlisted map[ibm.Client]bool) (bool, error) {
+	if !unlisted[client] {
+		policies, err := client.GetBackupPolicies(context.Background())
+		if err == nil {
+			return policies[id], nil
+		}
+		if !errors.Is(err, ibm.ErrTooManyPages) {
+			return false, err
+		}
+		unlisted[client] = true
+	}
+	return client.ValidateBackupPolicy(context.Background(), id)
+}
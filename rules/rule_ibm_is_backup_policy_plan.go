@@ -0,0 +1,221 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+const (
+	// minBackupDeleteAfter and maxBackupDeleteAfter bound the days a backup is kept
+	minBackupDeleteAfter = 1
+	maxBackupDeleteAfter = 1000
+	// maxBackupDeleteOverCount is the maximum number of backups a plan can keep
+	maxBackupDeleteOverCount = 750
+)
+
+// cronField is a field of a cron specification
+type cronField struct {
+	name     string
+	min, max int
+}
+
+// cronFields are the fields of the cron specifications accepted by backup policy plans.
+// Names of months and days are not supported.
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// IBMIsBackupPolicyPlanRule checks the schedule and retention of backup policy plans
+type IBMIsBackupPolicyPlanRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMIsBackupPolicyPlanRule returns a new rule
+func NewIBMIsBackupPolicyPlanRule() *IBMIsBackupPolicyPlanRule {
+	return &IBMIsBackupPolicyPlanRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsBackupPolicyPlanRule) Name() string {
+	return "ibm_is_backup_policy_plan"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsBackupPolicyPlanRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsBackupPolicyPlanRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMIsBackupPolicyPlanRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsBackupPolicyPlanRule) Metadata() interface{} {
+	return Metadata{Category: "Backup Policy", DeepCheck: DeepCheckNone}
+}
+
+// Check checks `cron_spec` and `deletion_trigger` of every backup policy plan
+func (r *IBMIsBackupPolicyPlanRule) Check(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("ibm_is_backup_policy_plan", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "cron_spec"}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "deletion_trigger",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "delete_after"},
+						{Name: "delete_over_count"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		if attr, exists := resource.Body.Attributes["cron_spec"]; exists {
+			spec, known, err := evaluateString(runner, attr)
+			if err != nil {
+				return err
+			}
+			if known {
+				if message := validateBackupCronSpec(spec); message != "" {
+					runner.EmitIssue(r, message, attr.Expr.Range())
+				}
+			}
+		}
+
+		for _, trigger := range resource.Body.Blocks {
+			if err := r.checkDeletionTrigger(runner, trigger.Body); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *IBMIsBackupPolicyPlanRule) checkDeletionTrigger(runner tflint.Runner, body *hclext.BodyContent) error {
+	for _, bound := range []struct {
+		name     string
+		min, max int
+	}{
+		{name: "delete_after", min: minBackupDeleteAfter, max: maxBackupDeleteAfter},
+		{name: "delete_over_count", min: 0, max: maxBackupDeleteOverCount},
+	} {
+		attr, exists := body.Attributes[bound.name]
+		if !exists {
+			continue
+		}
+		val, known, err := evaluateInt(runner, attr)
+		if err != nil {
+			return err
+		}
+		if known && (val < bound.min || val > bound.max) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` must be between %d and %d, got %d", bound.name, bound.min, bound.max, val),
+				attr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
+
+// validateBackupCronSpec checks the syntax of a cron specification and that
+// it runs at most once an hour, the shortest interval of backup policy plans.
+// It returns an empty string for valid specifications.
+func validateBackupCronSpec(spec string) string {
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return fmt.Sprintf("cron_spec \"%s\" must have %d fields: minute, hour, day of month, month and day of week", spec, len(cronFields))
+	}
+
+	var minutes int
+	for i, field := range cronFields {
+		values, err := field.expand(fields[i])
+		if err != nil {
+			return fmt.Sprintf("cron_spec \"%s\" has an invalid %s field \"%s\": %s", spec, field.name, fields[i], err)
+		}
+		if i == 0 {
+			minutes = len(values)
+		}
+	}
+
+	if minutes > 1 {
+		return fmt.Sprintf("cron_spec \"%s\" runs more than once an hour; backups can be scheduled at most hourly, so the minute field must be a single value", spec)
+	}
+	return ""
+}
+
+// expand returns the values matched by a cron field, e.g. 0, 15, 30 and 45 for `*/15`
+func (f cronField) expand(field string) (map[int]bool, error) {
+	values := map[int]bool{}
+
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return nil, fmt.Errorf("step must be a positive number")
+			}
+		}
+
+		start, end := f.min, f.max
+		if rangePart != "*" {
+			first, last, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = f.parse(first); err != nil {
+				return nil, err
+			}
+			end = start
+			if isRange {
+				if end, err = f.parse(last); err != nil {
+					return nil, err
+				}
+				if start > end {
+					return nil, fmt.Errorf("range start %d is greater than its end %d", start, end)
+				}
+			} else if hasStep {
+				// `n/step` matches from n to the end of the field
+				end = f.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			values[v] = true
+		}
+	}
+	return values, nil
+}
+
+// parse parses a single value of the field
+func (f cronField) parse(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("\"%s\" is not a number", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("values must be between %d and %d", f.min, f.max)
+	}
+	return v, nil
+}
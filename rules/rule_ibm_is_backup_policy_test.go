@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+// existingBackupPolicyID is the only backup policy of the fake clients
+const existingBackupPolicyID = "r006-0fe9e5d8-0a4c-4818-96ec-e99ac3e4b8d1"
+
+func TestIBMIsBackupPolicyRule(t *testing.T) {
+	ruletest.Run(t, NewIBMIsBackupPolicyRule())
+}
+
+func TestIBMIsBackupPolicyRule_deepCheck(t *testing.T) {
+	client := &ibm.FakeClient{
+		RegionFunc: func() string { return "us-south" },
+		GetBackupPoliciesFunc: func(context.Context) (map[string]bool, error) {
+			return map[string]bool{existingBackupPolicyID: true}, nil
+		},
+	}
+	ruletest.Run(
+		t,
+		NewIBMIsBackupPolicyRule(),
+		ruletest.WithDir("testdata/ibm_is_backup_policy_deep_check"),
+		ruletest.WithClient(client),
+	)
+
+	if slices.Contains(client.Calls(), "ValidateBackupPolicy") {
+		t.Errorf("backup policies were looked up by ID, although they could be listed: %v", client.Calls())
+	}
+}
+
+func TestIBMIsBackupPolicyRule_deepCheckTooManyPages(t *testing.T) {
+	client := &ibm.FakeClient{
+		RegionFunc: func() string { return "us-south" },
+		GetBackupPoliciesFunc: func(context.Context) (map[string]bool, error) {
+			return nil, fmt.Errorf("failed to list backup_policies in region us-south: %w", ibm.ErrTooManyPages)
+		},
+		ValidateBackupPolicyFunc: func(_ context.Context, id string) (bool, error) {
+			return id == existingBackupPolicyID, nil
+		},
+	}
+	ruletest.Run(
+		t,
+		NewIBMIsBackupPolicyRule(),
+		ruletest.WithDir("testdata/ibm_is_backup_policy_deep_check"),
+		ruletest.WithClient(client),
+	)
+
+	// The list is only attempted once per client
+	calls := client.Calls()
+	if got := countCalls(calls, "GetBackupPolicies"); got != 1 {
+		t.Errorf("GetBackupPolicies was called %d times, want 1: %v", got, calls)
+	}
+	if got := countCalls(calls, "ValidateBackupPolicy"); got != 4 {
+		t.Errorf("ValidateBackupPolicy was called %d times, want 4: %v", got, calls)
+	}
+}
+
+func countCalls(calls []string, method string) int {
+	n := 0
+	for _, call := range calls {
+		if call == method {
+			n++
+		}
+	}
+	return n
+}
@@ -1,10 +1,13 @@
 package rules
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/hcl/v2"
 	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
 	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
 	"github.com/uibm/tflint-ruleset-ibm/project"
 )
 
@@ -13,6 +16,7 @@ type IBMIsInstanceRule struct {
 	tflint.DefaultRule
 	resourceType    string
 	attributeSchema []hclext.AttributeSchema
+	blockSchema     []hclext.BlockSchema
 }
 
 // NewIBMIsInstanceRule returns a new rule
@@ -25,9 +29,10 @@ func NewIBMIsInstanceRule() *IBMIsInstanceRule {
 			{Name: "image"},
 			{Name: "vpc"},
 			{Name: "zone"},
-			{Name: "keys"},
-			{Name: "primary_network_interface"},
+			{Name: "instance_template"},
+			providerAttribute,
 		},
+		blockSchema: instanceNetworkBlocks,
 	}
 }
 
@@ -51,16 +56,34 @@ func (r *IBMIsInstanceRule) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
+// Metadata returns the rule metadata
+func (r *IBMIsInstanceRule) Metadata() interface{} {
+	return Metadata{Category: "Instance", DeepCheck: DeepCheckOptional}
+}
+
 // Check performs the check for this rule
 func (r *IBMIsInstanceRule) Check(runner tflint.Runner) error {
 	resources, err := runner.GetResourceContent(r.resourceType, &hclext.BodySchema{
 		Attributes: r.attributeSchema,
+		Blocks:     r.blockSchema,
 	}, nil)
 	if err != nil {
 		return err
 	}
 
+	subnetZones, err := r.subnetZones(runner)
+	if err != nil {
+		return err
+	}
+
 	for _, resource := range resources.Blocks {
+		// API-backed checks only run in deep check mode, against the region
+		// of the provider configuration the resource uses
+		client, err := ibmClient(runner, resource)
+		if err != nil {
+			return err
+		}
+
 		// Check required attributes
 		if err := r.checkRequiredAttributes(runner, resource); err != nil {
 			return err
@@ -68,17 +91,28 @@ func (r *IBMIsInstanceRule) Check(runner tflint.Runner) error {
 
 		// Validate profile if specified
 		if attr, exists := resource.Body.Attributes["profile"]; exists {
-			if err := r.validateProfile(runner, attr); err != nil {
+			if err := r.validateProfile(runner, client, attr); err != nil {
 				return err
 			}
 		}
 
 		// Validate image if specified
 		if attr, exists := resource.Body.Attributes["image"]; exists {
-			if err := r.validateImage(runner, attr); err != nil {
+			if err := r.validateImage(runner, client, attr); err != nil {
 				return err
 			}
 		}
+
+		// Validate VPC if specified
+		if attr, exists := resource.Body.Attributes["vpc"]; exists {
+			if err := r.validateVPC(runner, client, attr); err != nil {
+				return err
+			}
+		}
+
+		if err := r.checkNetworkInterfaces(runner, client, resource, subnetZones); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -86,6 +120,10 @@ func (r *IBMIsInstanceRule) Check(runner tflint.Runner) error {
 
 func (r *IBMIsInstanceRule) checkRequiredAttributes(runner tflint.Runner, resource *hclext.Block) error {
 	requiredAttrs := []string{"name", "profile", "image", "vpc", "zone"}
+	// Instance templates provide the other attributes of instances created from them
+	if _, exists := resource.Body.Attributes["instance_template"]; exists {
+		requiredAttrs = []string{"name"}
+	}
 
 	for _, attr := range requiredAttrs {
 		if _, exists := resource.Body.Attributes[attr]; !exists {
@@ -99,44 +137,276 @@ func (r *IBMIsInstanceRule) checkRequiredAttributes(runner tflint.Runner, resour
 	return nil
 }
 
-func (r *IBMIsInstanceRule) validateProfile(runner tflint.Runner, attr *hclext.Attribute) error {
-	var profile string
-	err := runner.EvaluateExpr(attr.Expr, &profile, nil)
-	if err != nil {
-		return err
-	}
+func (r *IBMIsInstanceRule) validateProfile(runner tflint.Runner, client ibm.Client, attr *hclext.Attribute) error {
+	return runner.EvaluateExpr(attr.Expr, func(profile string) error {
+		if profile == "" {
+			runner.EmitIssue(
+				r,
+				"`profile` attribute cannot be empty",
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+
+		if successor, ok := ibm.InstanceProfileSuccessor(profile); ok {
+			return emitIssueWithStringFix(
+				runner,
+				r,
+				fmt.Sprintf("\"%s\" is a retired instance profile. Use \"%s\" instead", profile, successor),
+				attr.Expr,
+				successor,
+			)
+		}
+
+		// The API is authoritative in deep check mode, as the catalog may be outdated
+		if client != nil {
+			profiles, err := client.GetInstanceProfiles(context.Background())
+			if err != nil {
+				return err
+			}
+			if !profiles[profile] {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not an instance profile available in region %s", profile, client.Region()),
+					attr.Expr.Range(),
+				)
+			}
+			return nil
+		}
 
-	if profile == "" {
+		if _, ok := ibm.LookupInstanceProfile(profile); ok {
+			return nil
+		}
+		if _, ok := ibm.LookupBareMetalProfile(profile); ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is a bare metal server profile, not an instance profile", profile),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
 		runner.EmitIssue(
 			r,
-			"`profile` attribute cannot be empty",
+			fmt.Sprintf("\"%s\" is an invalid instance profile", profile),
 			attr.Expr.Range(),
 		)
+		return nil
+	}, nil)
+}
+
+func (r *IBMIsInstanceRule) validateImage(runner tflint.Runner, client ibm.Client, attr *hclext.Attribute) error {
+	return runner.EvaluateExpr(attr.Expr, func(image string) error {
+		if image == "" {
+			runner.EmitIssue(
+				r,
+				"`image` attribute cannot be empty",
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+
+		// Image IDs are region specific, so they can only be verified against the API
+		if ibm.IsResourceID(image) {
+			if client == nil {
+				return nil
+			}
+			images, err := client.GetImages(context.Background())
+			if err != nil {
+				return err
+			}
+			if !images[image] {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("image \"%s\" is not visible in region %s", image, client.Region()),
+					attr.Expr.Range(),
+				)
+			}
+			return nil
+		}
+
+		if _, ok := ibm.LookupImage(image); ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`image` must be an image ID, not the image name \"%s\". Use the `ibm_is_image` data source to look it up", image),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is an invalid image ID", image),
+			attr.Expr.Range(),
+		)
+		return nil
+	}, nil)
+}
+
+func (r *IBMIsInstanceRule) validateVPC(runner tflint.Runner, client ibm.Client, attr *hclext.Attribute) error {
+	if client == nil {
+		return nil
 	}
 
-	// Add more profile validation logic here
-	// For example, check if the profile exists in IBM Cloud
+	return runner.EvaluateExpr(attr.Expr, func(vpc string) error {
+		// References to managed VPCs are unknown at lint time; only literal IDs can be looked up
+		if !ibm.IsResourceID(vpc) {
+			return nil
+		}
 
-	return nil
+		exists, err := client.ValidateVPC(context.Background(), vpc)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("VPC \"%s\" does not exist in region %s", vpc, client.Region()),
+				attr.Expr.Range(),
+			)
+		}
+		return nil
+	}, nil)
 }
 
-func (r *IBMIsInstanceRule) validateImage(runner tflint.Runner, attr *hclext.Attribute) error {
-	var image string
-	err := runner.EvaluateExpr(attr.Expr, &image, nil)
+// subnetZones returns the zone of every subnet of the module by resource name
+func (r *IBMIsInstanceRule) subnetZones(runner tflint.Runner) (map[string]string, error) {
+	resources, err := runner.GetResourceContent("ibm_is_subnet", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "zone"}},
+	}, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if image == "" {
+	zones := map[string]string{}
+	for _, resource := range resources.Blocks {
+		attr, exists := resource.Body.Attributes["zone"]
+		if !exists {
+			continue
+		}
+		zone, known, err := evaluateString(runner, attr)
+		if err != nil {
+			return nil, err
+		}
+		if known {
+			zones[resource.Labels[1]] = zone
+		}
+	}
+	return zones, nil
+}
+
+// checkNetworkInterfaces checks that an instance uses either network interfaces
+// or network attachments, and that each interface has a subnet in the zone of the instance
+func (r *IBMIsInstanceRule) checkNetworkInterfaces(runner tflint.Runner, client ibm.Client, resource *hclext.Block, subnetZones map[string]string) error {
+	declared := map[string]bool{}
+	for _, block := range resource.Body.Blocks {
+		declared[block.Type] = true
+	}
+	interfaces := declared["primary_network_interface"] || declared["network_interfaces"]
+	attachments := declared["primary_network_attachment"] || declared["network_attachments"]
+
+	switch {
+	case interfaces && attachments:
 		runner.EmitIssue(
 			r,
-			"`image` attribute cannot be empty",
-			attr.Expr.Range(),
+			"network interfaces and network attachments cannot be combined. Use either `primary_network_interface` and `network_interfaces`, or `primary_network_attachment` and `network_attachments`",
+			resource.DefRange,
 		)
+	case declared["network_interfaces"] && !declared["primary_network_interface"]:
+		runner.EmitIssue(r, "`network_interfaces` require a `primary_network_interface` block", resource.DefRange)
+	case declared["network_attachments"] && !declared["primary_network_attachment"]:
+		runner.EmitIssue(r, "`network_attachments` require a `primary_network_attachment` block", resource.DefRange)
+	case !interfaces && !attachments:
+		// Instance templates provide the network interfaces of instances created from them
+		if _, exists := resource.Body.Attributes["instance_template"]; !exists {
+			runner.EmitIssue(r, "`primary_network_interface` or `primary_network_attachment` block must be specified", resource.DefRange)
+		}
 	}
 
-	// Add more image validation logic here
-	// For example, check if the image exists in IBM Cloud
+	zone, zoneKnown := "", false
+	if attr, exists := resource.Body.Attributes["zone"]; exists {
+		var err error
+		if zone, zoneKnown, err = evaluateString(runner, attr); err != nil {
+			return err
+		}
+	}
 
+	for _, iface := range instanceNetworkInterfaces(resource) {
+		attachment := iface.block == "primary_network_attachment" || iface.block == "network_attachments"
+		if iface.body == nil {
+			runner.EmitIssue(r, fmt.Sprintf("`%s` must have a `virtual_network_interface` block", iface.block), iface.defRange)
+			continue
+		}
+
+		attr, exists := iface.body.Attributes["subnet"]
+		if !exists {
+			if !attachment {
+				runner.EmitIssue(r, fmt.Sprintf("`subnet` must be specified in `%s`", iface.block), iface.defRange)
+				continue
+			}
+			// An attachment may use an existing virtual network interface instead
+			if _, exists := iface.body.Attributes["id"]; !exists {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`virtual_network_interface` of `%s` must specify `subnet` or the `id` of an existing virtual network interface", iface.block),
+					iface.defRange,
+				)
+			}
+			continue
+		}
+
+		if zoneKnown {
+			if err := r.checkSubnetZone(runner, client, attr, zone, subnetZones); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
+
+// checkSubnetZone checks that a subnet is in the zone of the instance. Subnets of
+// the module are looked up by reference, and literal subnet IDs in deep check mode.
+func (r *IBMIsInstanceRule) checkSubnetZone(runner tflint.Runner, client ibm.Client, attr *hclext.Attribute, zone string, subnetZones map[string]string) error {
+	if traversal, diags := hcl.AbsTraversalForExpr(attr.Expr); !diags.HasErrors() && !isEvaluable(traversal) {
+		if len(traversal) >= 2 && traversal.RootName() == "ibm_is_subnet" {
+			name, _ := traversal[1].(hcl.TraverseAttr)
+			if subnetZone, ok := subnetZones[name.Name]; ok && subnetZone != zone {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("subnet ibm_is_subnet.%s is in zone %s, but the instance is in zone %s", name.Name, subnetZone, zone),
+					attr.Expr.Range(),
+				)
+			}
+		}
+		return nil
+	}
+
+	if client == nil {
+		return nil
+	}
+	return runner.EvaluateExpr(attr.Expr, func(id string) error {
+		if !ibm.IsResourceID(id) {
+			return nil
+		}
+
+		subnet, err := client.GetSubnet(context.Background(), id)
+		if err != nil {
+			return err
+		}
+		if subnet == nil {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("subnet \"%s\" does not exist in region %s", id, client.Region()),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+		if subnet.Zone != nil && subnet.Zone.Name != nil && *subnet.Zone.Name != zone {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("subnet \"%s\" is in zone %s, but the instance is in zone %s", id, *subnet.Zone.Name, zone),
+				attr.Expr.Range(),
+			)
+		}
+		return nil
+	}, nil)
+}
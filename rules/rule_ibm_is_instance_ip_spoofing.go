@@ -0,0 +1,104 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// IBMIsInstanceIPSpoofingRule checks for network interfaces that allow IP spoofing
+type IBMIsInstanceIPSpoofingRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+}
+
+// NewIBMIsInstanceIPSpoofingRule returns a new rule
+func NewIBMIsInstanceIPSpoofingRule() *IBMIsInstanceIPSpoofingRule {
+	return &IBMIsInstanceIPSpoofingRule{
+		resourceTypes: []string{
+			"ibm_is_instance",
+			"ibm_is_instance_template",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *IBMIsInstanceIPSpoofingRule) Name() string {
+	return "ibm_is_instance_ip_spoofing"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsInstanceIPSpoofingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsInstanceIPSpoofingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *IBMIsInstanceIPSpoofingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsInstanceIPSpoofingRule) Metadata() interface{} {
+	return Metadata{Category: "Instance", DeepCheck: DeepCheckNone}
+}
+
+// Check checks `allow_ip_spoofing` of the network interfaces of instances and
+// instance templates, and of virtual network interfaces
+func (r *IBMIsInstanceIPSpoofingRule) Check(runner tflint.Runner) error {
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Blocks: instanceNetworkBlocks,
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			for _, iface := range instanceNetworkInterfaces(resource) {
+				if iface.body == nil {
+					continue
+				}
+				if err := r.checkAllowIPSpoofing(runner, iface.body, iface.block); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	resources, err := runner.GetResourceContent("ibm_is_virtual_network_interface", networkInterfaceSchema, nil)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources.Blocks {
+		if err := r.checkAllowIPSpoofing(runner, resource.Body, resource.Labels[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *IBMIsInstanceIPSpoofingRule) checkAllowIPSpoofing(runner tflint.Runner, body *hclext.BodyContent, block string) error {
+	attr, exists := body.Attributes["allow_ip_spoofing"]
+	if !exists {
+		return nil
+	}
+
+	return runner.EvaluateExpr(attr.Expr, func(allowed bool) error {
+		if allowed {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` allows IP spoofing. Only allow it on interfaces of network appliances, such as routers and firewalls", block),
+				attr.Expr.Range(),
+			)
+		}
+		return nil
+	}, nil)
+}
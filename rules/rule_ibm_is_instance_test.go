@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+func TestIBMIsInstanceRule(t *testing.T) {
+	ruletest.Run(t, NewIBMIsInstanceRule())
+}
+
+func TestIBMIsInstanceRule_deepCheck(t *testing.T) {
+	// subnetZones are the subnets of the region by ID
+	subnetZones := map[string]string{
+		"0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d": "us-south-1",
+		"0727-3f2e1d0c-9b8a-4766-8544-332211009988": "us-south-2",
+	}
+	client := &ibm.FakeClient{
+		RegionFunc: func() string { return "us-south" },
+		GetInstanceProfilesFunc: func(context.Context) (map[string]bool, error) {
+			return map[string]bool{"bx2-2x8": true}, nil
+		},
+		GetImagesFunc: func(context.Context) (map[string]bool, error) {
+			return map[string]bool{"r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c": true}, nil
+		},
+		ValidateVPCFunc: func(_ context.Context, id string) (bool, error) {
+			return id == "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b", nil
+		},
+		GetSubnetFunc: func(_ context.Context, id string) (*vpcv1.Subnet, error) {
+			zone, exists := subnetZones[id]
+			if !exists {
+				return nil, nil
+			}
+			return &vpcv1.Subnet{ID: &id, Zone: &vpcv1.ZoneReference{Name: &zone}}, nil
+		},
+	}
+	ruletest.Run(
+		t,
+		NewIBMIsInstanceRule(),
+		ruletest.WithDir("testdata/ibm_is_instance_deep_check"),
+		ruletest.WithClient(client),
+	)
+}
@@ -0,0 +1,523 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// networkACLPortSchema is the schema of `tcp` and `udp` blocks
+var networkACLPortSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "port_min"},
+		{Name: "port_max"},
+		{Name: "source_port_min"},
+		{Name: "source_port_max"},
+	},
+}
+
+// networkACLRuleAttributes are the attributes shared by inline `rules` blocks
+// and `ibm_is_network_acl_rule` resources
+var networkACLRuleAttributes = []hclext.AttributeSchema{
+	{Name: "name"},
+	{Name: "action"},
+	{Name: "direction"},
+	{Name: "source"},
+	{Name: "destination"},
+	{Name: "protocol"},
+	{Name: "port_min"},
+	{Name: "port_max"},
+	{Name: "source_port_min"},
+	{Name: "source_port_max"},
+	{Name: "type"},
+	{Name: "code"},
+}
+
+var networkACLRuleBlocks = []hclext.BlockSchema{
+	{Type: "tcp", Body: networkACLPortSchema},
+	{Type: "udp", Body: networkACLPortSchema},
+	{Type: "icmp", Body: securityGroupICMPSchema},
+}
+
+// IBMIsNetworkACLRulesRule checks the order and completeness of network ACL rules
+type IBMIsNetworkACLRulesRule struct {
+	tflint.DefaultRule
+}
+
+// portRange is an inclusive range of ports
+type portRange struct {
+	min, max int
+}
+
+var allPorts = portRange{min: minPort, max: maxPort}
+
+func (p portRange) covers(other portRange) bool {
+	return p.min <= other.min && other.max <= p.max
+}
+
+func (p portRange) overlaps(other portRange) bool {
+	return p.min <= other.max && other.min <= p.max
+}
+
+// aclRule is a network ACL rule, or the traffic of a flow, in evaluation order
+type aclRule struct {
+	name      string
+	action    string
+	direction string
+	protocol  string
+
+	source      netip.Prefix
+	destination netip.Prefix
+	ports       portRange
+	sourcePorts portRange
+	// icmpType and icmpCode are -1 when any type or code matches
+	icmpType int
+	icmpCode int
+
+	// known is false when the rule cannot be evaluated statically
+	known bool
+	// declared is the range of the rule's declaration, and nameAttr its name, if any
+	declared hcl.Range
+	nameAttr *hclext.Attribute
+}
+
+// label returns a human readable name of the rule
+func (a aclRule) label() string {
+	if a.name != "" {
+		return fmt.Sprintf("\"%s\"", a.name)
+	}
+	return fmt.Sprintf("at %s", a.declared)
+}
+
+// covers reports whether all traffic matched by other is also matched by a
+func (a aclRule) covers(other aclRule) bool {
+	if a.direction != other.direction || !prefixCovers(a.source, other.source) || !prefixCovers(a.destination, other.destination) {
+		return false
+	}
+	switch a.protocol {
+	case "all":
+		return true
+	case "tcp", "udp":
+		return other.protocol == a.protocol && a.ports.covers(other.ports) && a.sourcePorts.covers(other.sourcePorts)
+	case "icmp":
+		return other.protocol == "icmp" &&
+			(a.icmpType == -1 || a.icmpType == other.icmpType) &&
+			(a.icmpCode == -1 || a.icmpCode == other.icmpCode)
+	}
+	return false
+}
+
+// overlaps reports whether some traffic is matched by both rules
+func (a aclRule) overlaps(other aclRule) bool {
+	if a.direction != other.direction || !a.source.Overlaps(other.source) || !a.destination.Overlaps(other.destination) {
+		return false
+	}
+	if a.protocol == "all" || other.protocol == "all" {
+		return true
+	}
+	if a.protocol != other.protocol {
+		return false
+	}
+	switch a.protocol {
+	case "tcp", "udp":
+		return a.ports.overlaps(other.ports) && a.sourcePorts.overlaps(other.sourcePorts)
+	case "icmp":
+		return (a.icmpType == -1 || other.icmpType == -1 || a.icmpType == other.icmpType) &&
+			(a.icmpCode == -1 || other.icmpCode == -1 || a.icmpCode == other.icmpCode)
+	}
+	return false
+}
+
+// returnFlow returns the traffic that answers traffic matched by the rule
+func (a aclRule) returnFlow() aclRule {
+	direction := "inbound"
+	if a.direction == "inbound" {
+		direction = "outbound"
+	}
+	return aclRule{
+		direction:   direction,
+		protocol:    a.protocol,
+		source:      a.destination,
+		destination: a.source,
+		ports:       a.sourcePorts,
+		sourcePorts: a.ports,
+		icmpType:    -1,
+		icmpCode:    -1,
+	}
+}
+
+func prefixCovers(a, b netip.Prefix) bool {
+	return a.Bits() <= b.Bits() && a.Contains(b.Addr())
+}
+
+// NewIBMIsNetworkACLRulesRule returns a new rule
+func NewIBMIsNetworkACLRulesRule() *IBMIsNetworkACLRulesRule {
+	return &IBMIsNetworkACLRulesRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsNetworkACLRulesRule) Name() string {
+	return "ibm_is_network_acl_rules"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsNetworkACLRulesRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsNetworkACLRulesRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *IBMIsNetworkACLRulesRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsNetworkACLRulesRule) Metadata() interface{} {
+	return Metadata{Category: "Network ACL", DeepCheck: DeepCheckNone}
+}
+
+// Check checks the rules of each network ACL in evaluation order
+func (r *IBMIsNetworkACLRulesRule) Check(runner tflint.Runner) error {
+	acls, err := r.networkACLs(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, rules := range acls {
+		r.checkDuplicateNames(runner, rules)
+		r.checkShadowing(runner, rules)
+		r.checkReturnTraffic(runner, rules)
+	}
+	return nil
+}
+
+// standaloneACLRule is an `ibm_is_network_acl_rule` waiting to be placed in its ACL
+type standaloneACLRule struct {
+	acl     string
+	address string
+	rule    aclRule
+	// before is the `before` attribute of the rule and target the address of
+	// the rule it refers to, if `before` refers to an `ibm_is_network_acl_rule`
+	before *hclext.Attribute
+	target string
+}
+
+// networkACLs returns the rules of each network ACL in evaluation order.
+// Standalone rules are inserted before the rule referenced by `before`, or
+// appended in declaration order otherwise. References to rules that are not
+// standalone rules of the same ACL are reported.
+func (r *IBMIsNetworkACLRulesRule) networkACLs(runner tflint.Runner) ([][]aclRule, error) {
+	resources, err := runner.GetResourceContent("ibm_is_network_acl", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "rules",
+				Body: &hclext.BodySchema{
+					Attributes: networkACLRuleAttributes,
+					Blocks:     networkACLRuleBlocks,
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	acls := map[string][]aclRule{}
+	// ruleKeys holds the reference key of each standalone rule by ACL, parallel to acls
+	ruleKeys := map[string][]string{}
+
+	for _, resource := range resources.Blocks {
+		key := resourceAddress(resource) + ".id"
+		keys = append(keys, key)
+		acls[key] = nil
+		for _, block := range resource.Body.Blocks {
+			rule, err := decodeACLRule(runner, block.Body, block.DefRange)
+			if err != nil {
+				return nil, err
+			}
+			acls[key] = append(acls[key], rule)
+			ruleKeys[key] = append(ruleKeys[key], "")
+		}
+	}
+
+	standalone, err := runner.GetResourceContent("ibm_is_network_acl_rule", &hclext.BodySchema{
+		Attributes: append([]hclext.AttributeSchema{{Name: "network_acl"}, {Name: "before"}}, networkACLRuleAttributes...),
+		Blocks:     networkACLRuleBlocks,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []standaloneACLRule
+	for _, resource := range standalone.Blocks {
+		attr, exists := resource.Body.Attributes["network_acl"]
+		if !exists {
+			continue
+		}
+		key, err := referenceKey(runner, attr)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			continue
+		}
+		if _, exists := acls[key]; !exists {
+			keys = append(keys, key)
+			acls[key] = nil
+		}
+
+		rule, err := decodeACLRule(runner, resource.Body, resource.DefRange)
+		if err != nil {
+			return nil, err
+		}
+
+		// `before` refers to the rule_id of another rule, e.g. `ibm_is_network_acl_rule.deny.rule_id`
+		p := standaloneACLRule{acl: key, address: resourceAddress(resource), rule: rule}
+		if before, exists := resource.Body.Attributes["before"]; exists {
+			if traversal, diags := hcl.AbsTraversalForExpr(before.Expr); !diags.HasErrors() && len(traversal) >= 2 && traversal.RootName() == "ibm_is_network_acl_rule" {
+				p.before = before
+				p.target = traversalString(traversal[:2])
+			}
+		}
+		pending = append(pending, p)
+	}
+
+	// Rules may be placed before rules declared later, so insertion is repeated
+	// until no more rules can be placed
+	for progress := true; progress; {
+		progress = false
+		var unresolved []standaloneACLRule
+		for _, p := range pending {
+			index := len(acls[p.acl])
+			if p.target != "" {
+				if index = slices.Index(ruleKeys[p.acl], p.target); index < 0 {
+					unresolved = append(unresolved, p)
+					continue
+				}
+			}
+			acls[p.acl] = slices.Insert(acls[p.acl], index, p.rule)
+			ruleKeys[p.acl] = slices.Insert(ruleKeys[p.acl], index, p.address)
+			progress = true
+		}
+		pending = unresolved
+	}
+
+	// The remaining rules refer to rules of other ACLs, undeclared rules or each other
+	for _, p := range pending {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`before` refers to %s, which is not a rule of the same network ACL. The rule is checked as the last rule of the ACL", p.target),
+			p.before.Expr.Range(),
+		)
+		acls[p.acl] = append(acls[p.acl], p.rule)
+		ruleKeys[p.acl] = append(ruleKeys[p.acl], p.address)
+	}
+
+	ret := make([][]aclRule, 0, len(keys))
+	for _, key := range keys {
+		ret = append(ret, acls[key])
+	}
+	return ret, nil
+}
+
+// decodeACLRule decodes a rule. Rules with values that are not known
+// statically are returned with known set to false.
+func decodeACLRule(runner tflint.Runner, body *hclext.BodyContent, declared hcl.Range) (aclRule, error) {
+	rule := aclRule{
+		protocol:    "all",
+		ports:       allPorts,
+		sourcePorts: allPorts,
+		icmpType:    -1,
+		icmpCode:    -1,
+		known:       true,
+		declared:    declared,
+		nameAttr:    body.Attributes["name"],
+	}
+
+	attrs := map[string]*string{
+		"name":      &rule.name,
+		"action":    &rule.action,
+		"direction": &rule.direction,
+		"protocol":  &rule.protocol,
+	}
+	for name, target := range attrs {
+		attr, exists := body.Attributes[name]
+		if !exists {
+			continue
+		}
+		val, known, err := evaluateString(runner, attr)
+		if err != nil {
+			return rule, err
+		}
+		if !known {
+			rule.known = false
+			continue
+		}
+		*target = val
+	}
+	if rule.action == "" || rule.direction == "" {
+		rule.known = false
+	}
+
+	for name, target := range map[string]*netip.Prefix{"source": &rule.source, "destination": &rule.destination} {
+		attr, exists := body.Attributes[name]
+		if !exists {
+			rule.known = false
+			continue
+		}
+		val, known, err := evaluateString(runner, attr)
+		if err != nil {
+			return rule, err
+		}
+		prefix, ok := parseACLAddress(val)
+		if !known || !ok {
+			rule.known = false
+			continue
+		}
+		*target = prefix
+	}
+
+	// The protocol is set by either a block or attributes
+	params := body
+	for _, block := range body.Blocks {
+		rule.protocol = block.Type
+		params = block.Body
+	}
+
+	ints := map[string]*int{
+		"port_min":        &rule.ports.min,
+		"port_max":        &rule.ports.max,
+		"source_port_min": &rule.sourcePorts.min,
+		"source_port_max": &rule.sourcePorts.max,
+		"type":            &rule.icmpType,
+		"code":            &rule.icmpCode,
+	}
+	for name, target := range ints {
+		attr, exists := params.Attributes[name]
+		if !exists {
+			continue
+		}
+		val, known, err := evaluateInt(runner, attr)
+		if err != nil {
+			return rule, err
+		}
+		if !known {
+			rule.known = false
+			continue
+		}
+		*target = val
+	}
+
+	switch rule.protocol {
+	case "all", "tcp", "udp", "icmp":
+	default:
+		rule.known = false
+	}
+
+	return rule, nil
+}
+
+// parseACLAddress parses a source or destination, which is either a CIDR block or an IP address
+func parseACLAddress(val string) (netip.Prefix, bool) {
+	if prefix, err := netip.ParsePrefix(val); err == nil {
+		return prefix.Masked(), true
+	}
+	if addr, err := netip.ParseAddr(val); err == nil {
+		return netip.PrefixFrom(addr, addr.BitLen()), true
+	}
+	return netip.Prefix{}, false
+}
+
+func (r *IBMIsNetworkACLRulesRule) emit(runner tflint.Runner, rule aclRule, message string) {
+	rng := rule.declared
+	if rule.nameAttr != nil {
+		rng = rule.nameAttr.Expr.Range()
+	}
+	runner.EmitIssue(r, message, rng)
+}
+
+func (r *IBMIsNetworkACLRulesRule) checkDuplicateNames(runner tflint.Runner, rules []aclRule) {
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		if rule.name == "" {
+			continue
+		}
+		if seen[rule.name] {
+			r.emit(runner, rule, fmt.Sprintf("rule name \"%s\" is used more than once in this network ACL", rule.name))
+		}
+		seen[rule.name] = true
+	}
+}
+
+// checkShadowing reports rules that can never match, because an earlier rule
+// matches all of their traffic. Rules are evaluated in order and the first
+// matching rule wins.
+func (r *IBMIsNetworkACLRulesRule) checkShadowing(runner tflint.Runner, rules []aclRule) {
+	for i, rule := range rules {
+		if !rule.known {
+			continue
+		}
+		for _, earlier := range rules[:i] {
+			if !earlier.known || !earlier.covers(rule) {
+				continue
+			}
+			r.emit(runner, rule, fmt.Sprintf("rule %s can never match, because earlier rule %s (%s) matches all of its traffic", rule.label(), earlier.label(), earlier.action))
+			break
+		}
+	}
+}
+
+// checkReturnTraffic reports allowed TCP and UDP traffic whose return traffic
+// is denied. Network ACLs are stateless, so return traffic must be allowed
+// explicitly in the opposite direction.
+func (r *IBMIsNetworkACLRulesRule) checkReturnTraffic(runner tflint.Runner, rules []aclRule) {
+	for _, rule := range rules {
+		if !rule.known || rule.action != "allow" || rule.protocol == "icmp" {
+			continue
+		}
+
+		flow := rule.returnFlow()
+		var denied *aclRule
+		allowed := false
+		unknown := false
+
+	evaluate:
+		for i, other := range rules {
+			if !other.known {
+				unknown = true
+				break
+			}
+			if !other.overlaps(flow) {
+				continue
+			}
+			switch {
+			case other.action == "allow":
+				// Partially allowed return traffic is not reported
+				allowed = true
+				break evaluate
+			case other.covers(flow):
+				denied = &rules[i]
+				break evaluate
+			}
+		}
+		if allowed || unknown {
+			continue
+		}
+
+		message := fmt.Sprintf("rule %s allows %s %s traffic, but no %s rule allows the return traffic", rule.label(), rule.direction, rule.protocol, flow.direction)
+		if denied != nil {
+			message = fmt.Sprintf("rule %s allows %s %s traffic, but the return traffic is denied by rule %s", rule.label(), rule.direction, rule.protocol, denied.label())
+		}
+		r.emit(runner, rule, message)
+	}
+}
@@ -0,0 +1,178 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+const (
+	minPort     = 1
+	maxPort     = 65535
+	maxICMPType = 254
+	maxICMPCode = 255
+)
+
+// IBMIsSecurityGroupRuleInvalidRule checks for security group rules that the VPC API rejects
+type IBMIsSecurityGroupRuleInvalidRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMIsSecurityGroupRuleInvalidRule returns a new rule
+func NewIBMIsSecurityGroupRuleInvalidRule() *IBMIsSecurityGroupRuleInvalidRule {
+	return &IBMIsSecurityGroupRuleInvalidRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsSecurityGroupRuleInvalidRule) Name() string {
+	return "ibm_is_security_group_rule_invalid"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsSecurityGroupRuleInvalidRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsSecurityGroupRuleInvalidRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMIsSecurityGroupRuleInvalidRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsSecurityGroupRuleInvalidRule) Metadata() interface{} {
+	return Metadata{Category: "Security Group", DeepCheck: DeepCheckNone}
+}
+
+// Check checks protocols, port ranges and ICMP types of security group rules
+func (r *IBMIsSecurityGroupRuleInvalidRule) Check(runner tflint.Runner) error {
+	rules, err := securityGroupRules(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		// A rule applies to a single protocol
+		if len(rule.body.Blocks) > 1 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("only one of `icmp`, `tcp` and `udp` can be specified, but `%s` and `%s` are both set", rule.body.Blocks[0].Type, rule.body.Blocks[1].Type),
+				rule.body.Blocks[1].DefRange,
+			)
+		}
+		if attr, exists := rule.body.Attributes["protocol"]; exists && len(rule.body.Blocks) > 0 {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`protocol` cannot be combined with a `%s` block", rule.body.Blocks[0].Type),
+				attr.Expr.Range(),
+			)
+		}
+
+		for _, block := range rule.body.Blocks {
+			switch block.Type {
+			case "tcp", "udp":
+				if err := r.checkPorts(runner, block.Body); err != nil {
+					return err
+				}
+			case "icmp":
+				if err := r.checkICMP(runner, block.Body); err != nil {
+					return err
+				}
+			}
+		}
+		if err := r.checkPorts(runner, rule.body); err != nil {
+			return err
+		}
+		if err := r.checkICMP(runner, rule.body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *IBMIsSecurityGroupRuleInvalidRule) checkPorts(runner tflint.Runner, body *hclext.BodyContent) error {
+	ports := map[string]int{}
+
+	for _, name := range []string{"port_min", "port_max"} {
+		attr, exists := body.Attributes[name]
+		if !exists {
+			continue
+		}
+		port, known, err := evaluateInt(runner, attr)
+		if err != nil {
+			return err
+		}
+		if !known {
+			continue
+		}
+		if port < minPort || port > maxPort {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` must be between %d and %d, got %d", name, minPort, maxPort, port),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+		ports[name] = port
+	}
+
+	portMin, minKnown := ports["port_min"]
+	portMax, maxKnown := ports["port_max"]
+	if minKnown && maxKnown && portMin > portMax {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`port_min` (%d) must not be greater than `port_max` (%d)", portMin, portMax),
+			body.Attributes["port_min"].Expr.Range(),
+		)
+	}
+	return nil
+}
+
+func (r *IBMIsSecurityGroupRuleInvalidRule) checkICMP(runner tflint.Runner, body *hclext.BodyContent) error {
+	typeAttr, typeExists := body.Attributes["type"]
+	if typeExists {
+		icmpType, known, err := evaluateInt(runner, typeAttr)
+		if err != nil {
+			return err
+		}
+		if known && (icmpType < 0 || icmpType > maxICMPType) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("ICMP `type` must be between 0 and %d, got %d", maxICMPType, icmpType),
+				typeAttr.Expr.Range(),
+			)
+		}
+	}
+
+	codeAttr, codeExists := body.Attributes["code"]
+	if !codeExists {
+		return nil
+	}
+	if !typeExists {
+		runner.EmitIssue(
+			r,
+			"ICMP `code` cannot be specified without `type`",
+			codeAttr.Expr.Range(),
+		)
+		return nil
+	}
+	code, known, err := evaluateInt(runner, codeAttr)
+	if err != nil {
+		return err
+	}
+	if known && (code < 0 || code > maxICMPCode) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("ICMP `code` must be between 0 and %d, got %d", maxICMPCode, code),
+			codeAttr.Expr.Range(),
+		)
+	}
+	return nil
+}
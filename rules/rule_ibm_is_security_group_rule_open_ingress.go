@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/logger"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// IBMIsSecurityGroupRuleOpenIngressRule checks for security group rules that
+// expose sensitive services, such as SSH and databases, to the internet
+type IBMIsSecurityGroupRuleOpenIngressRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMIsSecurityGroupRuleOpenIngressRule returns a new rule
+func NewIBMIsSecurityGroupRuleOpenIngressRule() *IBMIsSecurityGroupRuleOpenIngressRule {
+	return &IBMIsSecurityGroupRuleOpenIngressRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsSecurityGroupRuleOpenIngressRule) Name() string {
+	return "ibm_is_security_group_rule_open_ingress"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsSecurityGroupRuleOpenIngressRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsSecurityGroupRuleOpenIngressRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *IBMIsSecurityGroupRuleOpenIngressRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsSecurityGroupRuleOpenIngressRule) Metadata() interface{} {
+	return Metadata{Category: "Security Group", DeepCheck: DeepCheckNone}
+}
+
+// Check checks security group rules, and the network interfaces of instances
+// that attach the security groups of those rules
+func (r *IBMIsSecurityGroupRuleOpenIngressRule) Check(runner tflint.Runner) error {
+	rules, err := securityGroupRules(runner)
+	if err != nil {
+		return err
+	}
+
+	// exposed maps security groups to the first exposure found
+	exposed := map[string]string{}
+	for _, rule := range rules {
+		exposure, rng, err := rule.openIngress(runner)
+		if err != nil {
+			return err
+		}
+		if exposure == "" {
+			continue
+		}
+
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("inbound rule allows %s", exposure),
+			rng,
+		)
+		if _, exists := exposed[rule.group]; rule.group != "" && !exists {
+			exposed[rule.group] = exposure
+		}
+	}
+
+	if len(exposed) == 0 {
+		return nil
+	}
+	return r.checkInstances(runner, exposed)
+}
+
+func (r *IBMIsSecurityGroupRuleOpenIngressRule) checkInstances(runner tflint.Runner, exposed map[string]string) error {
+	interfaceSchema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "security_groups"}},
+	}
+	instances, err := runner.GetResourceContent("ibm_is_instance", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "primary_network_interface", Body: interfaceSchema},
+			{Type: "network_interfaces", Body: interfaceSchema},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, instance := range instances.Blocks {
+		for _, nic := range instance.Body.Blocks {
+			attr, exists := nic.Body.Attributes["security_groups"]
+			if !exists {
+				continue
+			}
+
+			exprs, diags := hcl.ExprList(attr.Expr)
+			if diags.HasErrors() {
+				logger.Debug("security_groups is not a static list: %s", diags)
+				continue
+			}
+			for _, expr := range exprs {
+				group, err := referenceKey(runner, &hclext.Attribute{Name: attr.Name, Expr: expr, Range: expr.Range()})
+				if err != nil {
+					return err
+				}
+				exposure, exists := exposed[group]
+				if !exists {
+					continue
+				}
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("`%s` attaches %s, which allows %s", nic.Type, group, exposure),
+					expr.Range(),
+				)
+			}
+		}
+	}
+	return nil
+}
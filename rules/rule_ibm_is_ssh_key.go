@@ -0,0 +1,331 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// sshKeyTypeRSA and sshKeyTypeEd25519 are the values of `type` of ibm_is_ssh_key
+	sshKeyTypeRSA     = "rsa"
+	sshKeyTypeEd25519 = "ed25519"
+)
+
+// sshKeyTypes maps the algorithms of OpenSSH public keys to the key types supported by IBM Cloud
+var sshKeyTypes = map[string]string{
+	ssh.KeyAlgoRSA:     sshKeyTypeRSA,
+	ssh.KeyAlgoED25519: sshKeyTypeEd25519,
+}
+
+// IBMIsSSHKeyRule checks SSH keys and the keys of instances
+type IBMIsSSHKeyRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMIsSSHKeyRule returns a new rule
+func NewIBMIsSSHKeyRule() *IBMIsSSHKeyRule {
+	return &IBMIsSSHKeyRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsSSHKeyRule) Name() string {
+	return "ibm_is_ssh_key"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsSSHKeyRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsSSHKeyRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMIsSSHKeyRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsSSHKeyRule) Metadata() interface{} {
+	return Metadata{Category: "SSH Key", DeepCheck: DeepCheckOptional}
+}
+
+// Check checks the public keys of SSH keys, and the keys of every instance
+// against its image
+func (r *IBMIsSSHKeyRule) Check(runner tflint.Runner) error {
+	keyTypes, err := r.checkKeys(runner)
+	if err != nil {
+		return err
+	}
+
+	images, err := imageDataSources(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent("ibm_is_instance", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "image"},
+			{Name: "keys"},
+			{Name: "user_data"},
+			providerAttribute,
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		client, err := ibmClient(runner, resource)
+		if err != nil {
+			return err
+		}
+
+		image, imageKnown, err := instanceImage(runner, resource, images)
+		if err != nil {
+			return err
+		}
+		windows := imageKnown && isWindowsImage(image)
+
+		hasKeys := false
+		if attr, exists := resource.Body.Attributes["keys"]; exists {
+			if hasKeys, err = r.checkInstanceKeys(runner, client, attr, keyTypes, windows); err != nil {
+				return err
+			}
+		}
+
+		if !imageKnown || windows || hasKeys {
+			continue
+		}
+		injected, err := userDataInjectsKey(runner, resource)
+		if err != nil {
+			return err
+		}
+		if injected {
+			continue
+		}
+
+		rng := resource.DefRange
+		if attr, exists := resource.Body.Attributes["keys"]; exists {
+			rng = attr.Expr.Range()
+		}
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("instances with the Linux image \"%s\" must have at least one SSH key in `keys`, unless `user_data` adds one", image.Name),
+			rng,
+		)
+	}
+
+	return nil
+}
+
+// checkKeys checks `public_key` and `type` of every SSH key, and returns the
+// key type of each SSH key by resource name
+func (r *IBMIsSSHKeyRule) checkKeys(runner tflint.Runner) (map[string]string, error) {
+	resources, err := runner.GetResourceContent("ibm_is_ssh_key", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "public_key"},
+			{Name: "type"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	keyTypes := map[string]string{}
+	for _, resource := range resources.Blocks {
+		// The API defaults to RSA keys
+		declared, declaredKnown := sshKeyTypeRSA, true
+		if attr, exists := resource.Body.Attributes["type"]; exists {
+			if declared, declaredKnown, err = evaluateString(runner, attr); err != nil {
+				return nil, err
+			}
+			if declaredKnown && declared != sshKeyTypeRSA && declared != sshKeyTypeEd25519 {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is an invalid SSH key type. Valid types are \"%s\" and \"%s\"", declared, sshKeyTypeRSA, sshKeyTypeEd25519),
+					attr.Expr.Range(),
+				)
+				continue
+			}
+		}
+
+		keyType, keyTypeKnown := declared, declaredKnown
+		if attr, exists := resource.Body.Attributes["public_key"]; exists {
+			material, known, err := evaluateString(runner, attr)
+			if err != nil {
+				return nil, err
+			}
+			if known {
+				parsed, ok := r.checkPublicKey(runner, attr, material)
+				if !ok {
+					continue
+				}
+				keyType, keyTypeKnown = parsed, true
+
+				if declaredKnown && declared != parsed {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("`public_key` is an %s key, but the key type is \"%s\". Set `type` to \"%s\"", parsed, declared, parsed),
+						attr.Expr.Range(),
+					)
+				}
+			}
+		}
+
+		if keyTypeKnown {
+			keyTypes[resource.Labels[1]] = keyType
+		}
+	}
+	return keyTypes, nil
+}
+
+// checkPublicKey checks that a public key is an OpenSSH key of a supported
+// type, and returns its key type
+func (r *IBMIsSSHKeyRule) checkPublicKey(runner tflint.Runner, attr *hclext.Attribute, material string) (string, bool) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(material))
+	if err != nil {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`public_key` is not a valid OpenSSH public key: %s", err),
+			attr.Expr.Range(),
+		)
+		return "", false
+	}
+
+	keyType, ok := sshKeyTypes[key.Type()]
+	if !ok {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`public_key` is an unsupported %s key. Only RSA and Ed25519 keys are supported", key.Type()),
+			attr.Expr.Range(),
+		)
+		return "", false
+	}
+	return keyType, true
+}
+
+// checkInstanceKeys checks each key of an instance, and reports whether the
+// instance has any key. Keys of unknown lists are assumed to be present.
+func (r *IBMIsSSHKeyRule) checkInstanceKeys(runner tflint.Runner, client ibm.Client, attr *hclext.Attribute, keyTypes map[string]string, windows bool) (bool, error) {
+	exprs, diags := hcl.ExprList(attr.Expr)
+	if diags.HasErrors() {
+		// Keys such as `var.ssh_keys` are checked as a whole
+		var (
+			keys  []string
+			known bool
+		)
+		err := runner.EvaluateExpr(attr.Expr, func(v []string) error {
+			keys, known = v, true
+			return nil
+		}, nil)
+		if err != nil || !known {
+			return true, err
+		}
+		for _, key := range keys {
+			if err := r.checkKeyID(runner, client, attr.Expr, key, windows); err != nil {
+				return false, err
+			}
+		}
+		return len(keys) > 0, nil
+	}
+
+	for _, expr := range exprs {
+		// References to managed keys, e.g. `ibm_is_ssh_key.example.id`, are unknown at lint time
+		if traversal, diags := hcl.AbsTraversalForExpr(expr); !diags.HasErrors() && !isEvaluable(traversal) {
+			if len(traversal) >= 2 && traversal.RootName() == "ibm_is_ssh_key" {
+				name, _ := traversal[1].(hcl.TraverseAttr)
+				if windows && keyTypes[name.Name] == sshKeyTypeEd25519 {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("ibm_is_ssh_key.%s is an Ed25519 key, which Windows images do not support. Use an RSA key", name.Name),
+						expr.Range(),
+					)
+				}
+			}
+			continue
+		}
+
+		if err := runner.EvaluateExpr(expr, func(key string) error {
+			return r.checkKeyID(runner, client, expr, key, windows)
+		}, nil); err != nil {
+			return false, err
+		}
+	}
+	return len(exprs) > 0, nil
+}
+
+// checkKeyID checks that a literal key is an SSH key ID, and in deep check mode
+// that the key exists and, for Windows images, is not an Ed25519 key
+func (r *IBMIsSSHKeyRule) checkKeyID(runner tflint.Runner, client ibm.Client, expr hcl.Expression, key string, windows bool) error {
+	if !ibm.IsResourceID(key) {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is an invalid SSH key ID. Keys must be referenced by ID, such as \"r006-…\", not by name", key),
+			expr.Range(),
+		)
+		return nil
+	}
+	if client == nil {
+		return nil
+	}
+
+	keys, err := client.GetSSHKeys(context.Background())
+	if err != nil {
+		return err
+	}
+	if !keys[key] {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("SSH key \"%s\" does not exist in region %s", key, client.Region()),
+			expr.Range(),
+		)
+		return nil
+	}
+	if !windows {
+		return nil
+	}
+
+	details, err := client.GetSSHKey(context.Background(), key)
+	if err != nil {
+		return err
+	}
+	if details != nil && details.Type != nil && *details.Type == sshKeyTypeEd25519 {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("SSH key \"%s\" is an Ed25519 key, which Windows images do not support. Use an RSA key", key),
+			expr.Range(),
+		)
+	}
+	return nil
+}
+
+// userDataInjectsKey reports whether the `user_data` of an instance may add an
+// SSH key, e.g. with the cloud-init `ssh_authorized_keys` module. Unknown user
+// data is assumed to add one.
+func userDataInjectsKey(runner tflint.Runner, resource *hclext.Block) (bool, error) {
+	attr, exists := resource.Body.Attributes["user_data"]
+	if !exists {
+		return false, nil
+	}
+	userData, known, err := evaluateString(runner, attr)
+	if err != nil || !known {
+		return true, err
+	}
+	for _, marker := range []string{"ssh_authorized_keys", "ssh-rsa ", "ssh-ed25519 "} {
+		if strings.Contains(userData, marker) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,45 @@
+package rules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+func TestIBMIsSSHKeyRule(t *testing.T) {
+	ruletest.Run(t, NewIBMIsSSHKeyRule())
+}
+
+func TestIBMIsSSHKeyRule_deepCheck(t *testing.T) {
+	// keyTypes are the SSH keys of the region by ID
+	keyTypes := map[string]string{
+		"r006-0f1e2d3c-4b5a-4697-8877-665544332211": sshKeyTypeEd25519,
+		"r006-7d6c5b4a-3928-4716-8a5b-4c3d2e1f0a9b": sshKeyTypeRSA,
+	}
+	client := &ibm.FakeClient{
+		RegionFunc: func() string { return "us-south" },
+		GetSSHKeysFunc: func(context.Context) (map[string]bool, error) {
+			keys := map[string]bool{}
+			for id := range keyTypes {
+				keys[id] = true
+			}
+			return keys, nil
+		},
+		GetSSHKeyFunc: func(_ context.Context, id string) (*vpcv1.Key, error) {
+			keyType, exists := keyTypes[id]
+			if !exists {
+				return nil, nil
+			}
+			return &vpcv1.Key{ID: &id, Type: &keyType}, nil
+		},
+	}
+	ruletest.Run(
+		t,
+		NewIBMIsSSHKeyRule(),
+		ruletest.WithDir("testdata/ibm_is_ssh_key_deep_check"),
+		ruletest.WithClient(client),
+	)
+}
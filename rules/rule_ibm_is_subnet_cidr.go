@@ -0,0 +1,325 @@
+package rules
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+const (
+	// minCIDRPrefixLength and maxCIDRPrefixLength are the prefix lengths
+	// accepted by the VPC API for subnets and address prefixes
+	minCIDRPrefixLength = 9
+	maxCIDRPrefixLength = 29
+)
+
+// reservedCIDRBlocks are ranges that cannot be used in a VPC
+var reservedCIDRBlocks = []netip.Prefix{
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("161.26.0.0/16"),
+	netip.MustParsePrefix("166.8.0.0/14"),
+	netip.MustParsePrefix("169.254.0.0/16"),
+	netip.MustParsePrefix("224.0.0.0/4"),
+}
+
+// IBMIsSubnetCIDRRule checks the CIDR blocks of subnets and VPC address prefixes
+type IBMIsSubnetCIDRRule struct {
+	tflint.DefaultRule
+}
+
+// cidrBlock is a CIDR block declared by a subnet or an address prefix
+type cidrBlock struct {
+	address string
+	vpc     string
+	zone    string
+	prefix  netip.Prefix
+	attr    *hclext.Attribute
+}
+
+// NewIBMIsSubnetCIDRRule returns a new rule
+func NewIBMIsSubnetCIDRRule() *IBMIsSubnetCIDRRule {
+	return &IBMIsSubnetCIDRRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsSubnetCIDRRule) Name() string {
+	return "ibm_is_subnet_cidr"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsSubnetCIDRRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsSubnetCIDRRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMIsSubnetCIDRRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsSubnetCIDRRule) Metadata() interface{} {
+	return Metadata{Category: "Subnet", DeepCheck: DeepCheckNone}
+}
+
+// Check checks CIDR syntax and sizes, overlaps within a VPC,
+// containment of subnets in the address prefixes of their VPC and
+// the address counts of subnets
+func (r *IBMIsSubnetCIDRRule) Check(runner tflint.Runner) error {
+	prefixes, err := r.collect(runner, "ibm_is_vpc_address_prefix", "cidr")
+	if err != nil {
+		return err
+	}
+	subnets, err := r.collect(runner, "ibm_is_subnet", "ipv4_cidr_block")
+	if err != nil {
+		return err
+	}
+
+	r.checkOverlaps(runner, prefixes, "address prefix")
+	r.checkOverlaps(runner, subnets, "subnet")
+
+	if err := r.checkAddressCounts(runner); err != nil {
+		return err
+	}
+
+	autoVPCs, err := r.autoAddressPrefixVPCs(runner)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range subnets {
+		if subnet.vpc == "" || subnet.zone == "" || autoVPCs[subnet.vpc] {
+			continue
+		}
+
+		// Only VPCs whose address prefixes are declared in this module can be checked
+		var candidates []string
+		contained := false
+		for _, prefix := range prefixes {
+			if prefix.vpc != subnet.vpc || prefix.zone != subnet.zone {
+				continue
+			}
+			candidates = append(candidates, prefix.prefix.String())
+			if prefix.prefix.Bits() <= subnet.prefix.Bits() && prefix.prefix.Contains(subnet.prefix.Addr()) {
+				contained = true
+				break
+			}
+		}
+		if contained || !hasVPC(prefixes, subnet.vpc) {
+			continue
+		}
+
+		message := fmt.Sprintf("\"%s\" is not contained in any address prefix of the VPC in zone %s", subnet.prefix, subnet.zone)
+		if len(candidates) > 0 {
+			message += fmt.Sprintf(" (%s)", strings.Join(candidates, ", "))
+		}
+		runner.EmitIssue(r, message, subnet.attr.Expr.Range())
+	}
+
+	return nil
+}
+
+// collect decodes and validates the CIDR blocks of the given resource type.
+// Only valid blocks are returned.
+func (r *IBMIsSubnetCIDRRule) collect(runner tflint.Runner, resourceType string, attrName string) ([]cidrBlock, error) {
+	resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: attrName},
+			{Name: "vpc"},
+			{Name: "zone"},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []cidrBlock
+	for _, resource := range resources.Blocks {
+		attr, exists := resource.Body.Attributes[attrName]
+		if !exists {
+			continue
+		}
+
+		cidr, known, err := evaluateString(runner, attr)
+		if err != nil {
+			return nil, err
+		}
+		if !known {
+			continue
+		}
+		prefix, ok := r.validateCIDR(runner, cidr, attr)
+		if !ok {
+			continue
+		}
+
+		block := cidrBlock{address: resourceAddress(resource), prefix: prefix, attr: attr}
+		if vpc, exists := resource.Body.Attributes["vpc"]; exists {
+			if block.vpc, err = referenceKey(runner, vpc); err != nil {
+				return nil, err
+			}
+		}
+		if zone, exists := resource.Body.Attributes["zone"]; exists {
+			if block.zone, _, err = evaluateString(runner, zone); err != nil {
+				return nil, err
+			}
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+func (r *IBMIsSubnetCIDRRule) validateCIDR(runner tflint.Runner, cidr string, attr *hclext.Attribute) (netip.Prefix, bool) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not a valid CIDR block", cidr),
+			attr.Expr.Range(),
+		)
+		return prefix, false
+	}
+	if !prefix.Addr().Is4() {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not an IPv4 CIDR block", cidr),
+			attr.Expr.Range(),
+		)
+		return prefix, false
+	}
+	if prefix.Bits() < minCIDRPrefixLength || prefix.Bits() > maxCIDRPrefixLength {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" must have a prefix length between /%d and /%d", cidr, minCIDRPrefixLength, maxCIDRPrefixLength),
+			attr.Expr.Range(),
+		)
+		return prefix, false
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("\"%s\" is not a network address. Did you mean \"%s\"?", cidr, masked),
+			attr.Expr.Range(),
+		)
+		return prefix, false
+	}
+	for _, reserved := range reservedCIDRBlocks {
+		if reserved.Overlaps(prefix) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" overlaps the reserved range %s", cidr, reserved),
+				attr.Expr.Range(),
+			)
+			return prefix, false
+		}
+	}
+	return prefix, true
+}
+
+// checkOverlaps reports blocks that overlap an earlier block in the same VPC
+func (r *IBMIsSubnetCIDRRule) checkOverlaps(runner tflint.Runner, blocks []cidrBlock, kind string) {
+	for i, block := range blocks {
+		if block.vpc == "" {
+			continue
+		}
+		for _, other := range blocks[:i] {
+			if other.vpc != block.vpc || !other.prefix.Overlaps(block.prefix) {
+				continue
+			}
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("%s \"%s\" overlaps \"%s\" of %s in the same VPC", kind, block.prefix, other.prefix, other.address),
+				block.attr.Expr.Range(),
+			)
+			break
+		}
+	}
+}
+
+// checkAddressCounts checks `total_ipv4_address_count` of subnets, which
+// conflicts with `ipv4_cidr_block` and must be the size of a valid CIDR block
+func (r *IBMIsSubnetCIDRRule) checkAddressCounts(runner tflint.Runner) error {
+	resources, err := runner.GetResourceContent("ibm_is_subnet", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "ipv4_cidr_block"},
+			{Name: "total_ipv4_address_count"},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		attr, exists := resource.Body.Attributes["total_ipv4_address_count"]
+		if !exists {
+			continue
+		}
+		if _, exists := resource.Body.Attributes["ipv4_cidr_block"]; exists {
+			runner.EmitIssue(
+				r,
+				"`total_ipv4_address_count` conflicts with `ipv4_cidr_block`. Set only one of them",
+				attr.Expr.Range(),
+			)
+			continue
+		}
+
+		count, known, err := evaluateInt(runner, attr)
+		if err != nil {
+			return err
+		}
+		min, max := 1<<(32-maxCIDRPrefixLength), 1<<(32-minCIDRPrefixLength)
+		if known && (count < min || count > max || count&(count-1) != 0) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`total_ipv4_address_count` must be a power of 2 between %d and %d, got %d", min, max, count),
+				attr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
+
+// autoAddressPrefixVPCs returns the references of VPCs declared in this module
+// that create default address prefixes, e.g. `ibm_is_vpc.example.id`.
+// Subnets of these VPCs may use prefixes that are not declared in the module.
+func (r *IBMIsSubnetCIDRRule) autoAddressPrefixVPCs(runner tflint.Runner) (map[string]bool, error) {
+	resources, err := runner.GetResourceContent("ibm_is_vpc", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "address_prefix_management"}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcs := map[string]bool{}
+	for _, resource := range resources.Blocks {
+		manual := false
+		if attr, exists := resource.Body.Attributes["address_prefix_management"]; exists {
+			val, known, err := evaluateString(runner, attr)
+			if err != nil {
+				return nil, err
+			}
+			manual = known && val == "manual"
+		}
+		if !manual {
+			vpcs[resourceAddress(resource)+".id"] = true
+		}
+	}
+	return vpcs, nil
+}
+
+func hasVPC(blocks []cidrBlock, vpc string) bool {
+	for _, block := range blocks {
+		if block.vpc == vpc {
+			return true
+		}
+	}
+	return false
+}
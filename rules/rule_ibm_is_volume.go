@@ -0,0 +1,355 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// defaultVolumeProfile is the profile of volumes that do not set `profile`
+const defaultVolumeProfile = "general-purpose"
+
+// volumeSchema is the schema of `ibm_is_volume` resources and of the
+// `volume_prototype` blocks of instance volume attachments
+var volumeSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "profile"},
+		{Name: "capacity"},
+		{Name: "iops"},
+		{Name: "encryption_key"},
+	},
+}
+
+// bootVolumeSchema is the schema of `boot_volume` blocks of instances
+var bootVolumeSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "profile"},
+		{Name: "size"},
+		{Name: "iops"},
+		{Name: "encryption"},
+	},
+}
+
+// volume is a volume declared by an `ibm_is_volume` resource, or by a boot
+// volume or volume attachment of an instance
+type volume struct {
+	// block is the type of the block declaring the volume, e.g. `boot_volume`
+	block    string
+	body     *hclext.BodyContent
+	defRange hcl.Range
+	// capacity and encryption are the names of the capacity and encryption key
+	// attributes, which differ between boot volumes and other volumes
+	capacity   string
+	encryption string
+	boot       bool
+}
+
+// IBMIsVolumeRule checks the profiles, capacities and encryption of volumes
+type IBMIsVolumeRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMIsVolumeRule returns a new rule
+func NewIBMIsVolumeRule() *IBMIsVolumeRule {
+	return &IBMIsVolumeRule{}
+}
+
+// Name returns the rule name
+func (r *IBMIsVolumeRule) Name() string {
+	return "ibm_is_volume"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMIsVolumeRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMIsVolumeRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMIsVolumeRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMIsVolumeRule) Metadata() interface{} {
+	return Metadata{Category: "Volume", DeepCheck: DeepCheckOptional}
+}
+
+// Check checks every volume, and the boot volumes and volume attachments of instances
+func (r *IBMIsVolumeRule) Check(runner tflint.Runner) error {
+	volumeZones, err := r.checkVolumeResources(runner)
+	if err != nil {
+		return err
+	}
+
+	images, err := imageDataSources(runner)
+	if err != nil {
+		return err
+	}
+
+	resources, err := runner.GetResourceContent("ibm_is_instance", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "image"},
+			{Name: "zone"},
+			{Name: "volumes"},
+			providerAttribute,
+		},
+		Blocks: []hclext.BlockSchema{
+			{Type: "boot_volume", Body: bootVolumeSchema},
+			{
+				Type: "volume_attachments",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "volume"}},
+					Blocks:     []hclext.BlockSchema{{Type: "volume_prototype", Body: volumeSchema}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources.Blocks {
+		client, err := ibmClient(runner, resource)
+		if err != nil {
+			return err
+		}
+
+		// Volumes attached to an instance must be in its zone
+		var attached []hcl.Expression
+		if attr, exists := resource.Body.Attributes["volumes"]; exists {
+			if exprs, diags := hcl.ExprList(attr.Expr); !diags.HasErrors() {
+				attached = append(attached, exprs...)
+			}
+		}
+
+		for _, block := range resource.Body.Blocks {
+			switch block.Type {
+			case "boot_volume":
+				boot := volume{block: block.Type, body: block.Body, defRange: block.DefRange, capacity: "size", encryption: "encryption", boot: true}
+				if err := r.checkVolume(runner, client, boot); err != nil {
+					return err
+				}
+				if err := r.checkBootVolumeSize(runner, resource, block.Body, images); err != nil {
+					return err
+				}
+			case "volume_attachments":
+				if attr, exists := block.Body.Attributes["volume"]; exists {
+					attached = append(attached, attr.Expr)
+				}
+				for _, prototype := range block.Body.Blocks {
+					data := volume{block: prototype.Type, body: prototype.Body, defRange: prototype.DefRange, capacity: "capacity", encryption: "encryption_key"}
+					if err := r.checkVolume(runner, client, data); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if attr, exists := resource.Body.Attributes["zone"]; exists && len(attached) > 0 {
+			zone, known, err := evaluateString(runner, attr)
+			if err != nil {
+				return err
+			}
+			if known {
+				r.checkVolumeZones(runner, attached, zone, volumeZones)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkVolumeResources checks every `ibm_is_volume`, and returns the zone of
+// each volume by resource name
+func (r *IBMIsVolumeRule) checkVolumeResources(runner tflint.Runner) (map[string]string, error) {
+	resources, err := runner.GetResourceContent("ibm_is_volume", &hclext.BodySchema{
+		Attributes: append([]hclext.AttributeSchema{{Name: "zone"}, providerAttribute}, volumeSchema.Attributes...),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := map[string]string{}
+	for _, resource := range resources.Blocks {
+		client, err := ibmClient(runner, resource)
+		if err != nil {
+			return nil, err
+		}
+		v := volume{block: resource.Labels[0], body: resource.Body, defRange: resource.DefRange, capacity: "capacity", encryption: "encryption_key"}
+		if err := r.checkVolume(runner, client, v); err != nil {
+			return nil, err
+		}
+
+		if attr, exists := resource.Body.Attributes["zone"]; exists {
+			zone, known, err := evaluateString(runner, attr)
+			if err != nil {
+				return nil, err
+			}
+			if known {
+				zones[resource.Labels[1]] = zone
+			}
+		}
+	}
+	return zones, nil
+}
+
+// checkVolume checks the profile, capacity, IOPS and encryption key of a volume
+func (r *IBMIsVolumeRule) checkVolume(runner tflint.Runner, client ibm.Client, v volume) error {
+	if attr, exists := v.body.Attributes[v.encryption]; exists {
+		crn, known, err := evaluateString(runner, attr)
+		if err != nil {
+			return err
+		}
+		if known && !ibm.IsEncryptionKeyCRN(crn) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is an invalid encryption key CRN. Use the CRN of a Key Protect or Hyper Protect Crypto Services root key, such as \"crn:v1:bluemix:public:kms:us-south:a/<account ID>:<instance ID>:key:<key ID>\"", crn),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	name := defaultVolumeProfile
+	if attr, exists := v.body.Attributes["profile"]; exists {
+		var (
+			known bool
+			err   error
+		)
+		if name, known, err = evaluateString(runner, attr); err != nil || !known {
+			return err
+		}
+
+		// The API is authoritative in deep check mode, as the catalog may be outdated
+		if client != nil {
+			profiles, err := client.GetVolumeProfiles(context.Background())
+			if err != nil {
+				return err
+			}
+			if !profiles[name] {
+				runner.EmitIssue(
+					r,
+					fmt.Sprintf("\"%s\" is not a volume profile available in region %s", name, client.Region()),
+					attr.Expr.Range(),
+				)
+				return nil
+			}
+		} else if _, ok := ibm.LookupVolumeProfile(name); !ok {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("\"%s\" is an invalid volume profile", name),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+	}
+
+	// Limits of profiles missing from the catalog are unknown
+	profile, ok := ibm.LookupVolumeProfile(name)
+	if !ok {
+		return nil
+	}
+
+	if attr, exists := v.body.Attributes[v.capacity]; exists {
+		capacity, known, err := evaluateInt(runner, attr)
+		if err != nil {
+			return err
+		}
+		min, max := profile.MinCapacity, profile.MaxCapacity
+		kind := "volumes"
+		if v.boot && profile.MaxBootCapacity > 0 {
+			max = profile.MaxBootCapacity
+			kind = "boot volumes"
+		}
+		if known && (capacity < min || (max > 0 && capacity > max)) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` must be between %d and %d GB for %s of the %s profile, got %d", v.capacity, min, max, kind, name, capacity),
+				attr.Expr.Range(),
+			)
+		}
+	}
+
+	attr, exists := v.body.Attributes["iops"]
+	switch {
+	case profile.MaxIOPS == 0 && exists:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`iops` cannot be set for the %s profile, whose IOPS are determined by its tier. Only custom and sdp volumes accept `iops`", name),
+			attr.Expr.Range(),
+		)
+	case profile.MaxIOPS > 0 && !exists:
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("`iops` must be specified in `%s` for the %s profile", v.block, name),
+			v.defRange,
+		)
+	case profile.MaxIOPS > 0:
+		iops, known, err := evaluateInt(runner, attr)
+		if err != nil {
+			return err
+		}
+		if known && (iops < profile.MinIOPS || iops > profile.MaxIOPS) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`iops` must be between %d and %d for the %s profile, got %d", profile.MinIOPS, profile.MaxIOPS, name, iops),
+				attr.Expr.Range(),
+			)
+		}
+	}
+	return nil
+}
+
+// checkBootVolumeSize checks that the boot volume of an instance is at least
+// the minimum provisioned size of its image
+func (r *IBMIsVolumeRule) checkBootVolumeSize(runner tflint.Runner, resource *hclext.Block, body *hclext.BodyContent, images map[string]string) error {
+	attr, exists := body.Attributes["size"]
+	if !exists {
+		return nil
+	}
+	image, imageKnown, err := instanceImage(runner, resource, images)
+	if err != nil || !imageKnown || image.MinimumProvisionedSize == 0 {
+		return err
+	}
+
+	size, known, err := evaluateInt(runner, attr)
+	if err != nil {
+		return err
+	}
+	if known && size < image.MinimumProvisionedSize {
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("boot volume `size` must be at least %d GB, the minimum provisioned size of image \"%s\", got %d", image.MinimumProvisionedSize, image.Name, size),
+			attr.Expr.Range(),
+		)
+	}
+	return nil
+}
+
+// checkVolumeZones checks that volumes of the module attached to an instance,
+// such as `ibm_is_volume.example.id`, are in the zone of the instance
+func (r *IBMIsVolumeRule) checkVolumeZones(runner tflint.Runner, attached []hcl.Expression, zone string, volumeZones map[string]string) {
+	for _, expr := range attached {
+		traversal, diags := hcl.AbsTraversalForExpr(expr)
+		if diags.HasErrors() || len(traversal) < 2 || traversal.RootName() != "ibm_is_volume" {
+			continue
+		}
+		name, _ := traversal[1].(hcl.TraverseAttr)
+		if volumeZone, ok := volumeZones[name.Name]; ok && volumeZone != zone {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("volume ibm_is_volume.%s is in zone %s, but the instance is in zone %s", name.Name, volumeZone, zone),
+				expr.Range(),
+			)
+		}
+	}
+}
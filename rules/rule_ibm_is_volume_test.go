@@ -0,0 +1,29 @@
+package rules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+func TestIBMIsVolumeRule(t *testing.T) {
+	ruletest.Run(t, NewIBMIsVolumeRule())
+}
+
+func TestIBMIsVolumeRule_deepCheck(t *testing.T) {
+	// sdp volumes are not available in the region
+	client := &ibm.FakeClient{
+		RegionFunc: func() string { return "us-south" },
+		GetVolumeProfilesFunc: func(context.Context) (map[string]bool, error) {
+			return map[string]bool{"general-purpose": true, "custom": true}, nil
+		},
+	}
+	ruletest.Run(
+		t,
+		NewIBMIsVolumeRule(),
+		ruletest.WithDir("testdata/ibm_is_volume_deep_check"),
+		ruletest.WithClient(client),
+	)
+}
@@ -30,11 +30,14 @@ func (r *IBMIsVPCRule) Link() string {
 	return project.ReferenceLink(r.Name())
 }
 
+func (r *IBMIsVPCRule) Metadata() interface{} {
+	return Metadata{Category: "VPC", DeepCheck: DeepCheckNone}
+}
+
 func (r *IBMIsVPCRule) Check(runner tflint.Runner) error {
 	resources, err := runner.GetResourceContent("ibm_is_vpc", &hclext.BodySchema{
 		Attributes: []hclext.AttributeSchema{
 			{Name: "name"},
-			{Name: "resource_group"},
 		},
 	}, nil)
 	if err != nil {
@@ -0,0 +1,214 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// IBMProviderReferenceRule checks the `provider` meta-argument of IBM Cloud resources and data sources
+type IBMProviderReferenceRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMProviderReferenceRule returns a new rule
+func NewIBMProviderReferenceRule() *IBMProviderReferenceRule {
+	return &IBMProviderReferenceRule{}
+}
+
+// Name returns the rule name
+func (r *IBMProviderReferenceRule) Name() string {
+	return "ibm_provider_reference"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMProviderReferenceRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMProviderReferenceRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMProviderReferenceRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMProviderReferenceRule) Metadata() interface{} {
+	return Metadata{Category: "Provider", DeepCheck: DeepCheckNone}
+}
+
+// Check checks the `provider` meta-argument of every resource and data source
+func (r *IBMProviderReferenceRule) Check(runner tflint.Runner) error {
+	aliases, known, err := r.declaredAliases(runner)
+	if err != nil {
+		return err
+	}
+
+	schema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{providerAttribute}}
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: schema},
+			{Type: "data", LabelNames: []string{"type", "name"}, Body: schema},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, block := range content.Blocks {
+		if !strings.HasPrefix(block.Labels[0], "ibm_") {
+			continue
+		}
+		attr, exists := block.Body.Attributes["provider"]
+		if !exists {
+			continue
+		}
+
+		ref, diags := ibm.DecodeProviderConfigRef(attr.Expr, "provider")
+		if err := r.emitDiagnostics(runner, attr.Expr, diags); err != nil {
+			return err
+		}
+		if diags.HasErrors() || ref == nil || ref.Name != ibm.DefaultProviderName || ref.Alias == "" || !known {
+			continue
+		}
+
+		if !aliases[ref.Alias] {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("provider configuration ibm.%s is not declared in this module", ref.Alias),
+				*ref.AliasRange,
+			)
+		}
+	}
+
+	return nil
+}
+
+// emitDiagnostics emits the diagnostics of DecodeProviderConfigRef as issues.
+// Quoted references and unnormalized provider names are fixed by rewriting
+// the whole reference, so the fix is attached to the first issue only.
+func (r *IBMProviderReferenceRule) emitDiagnostics(runner tflint.Runner, expr hcl.Expression, diags hcl.Diagnostics) error {
+	for i, diag := range diags {
+		message := fmt.Sprintf("%s. %s", diag.Summary, diag.Detail)
+		rng := expr.Range()
+		if diag.Subject != nil {
+			rng = *diag.Subject
+		}
+
+		var err error
+		if i == 0 {
+			err = emitIssueWithProviderRefFix(runner, r, message, rng, expr)
+		} else {
+			err = runner.EmitIssue(r, message, rng)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// declaredAliases returns the aliases of the `ibm` provider configurations available
+// in the module: aliases of `provider "ibm"` blocks, and `configuration_aliases`
+// that a child module expects from its caller. It returns false when an alias
+// cannot be determined, e.g. when it is set from a variable.
+func (r *IBMProviderReferenceRule) declaredAliases(runner tflint.Runner) (map[string]bool, bool, error) {
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "provider",
+				LabelNames: []string{"name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "alias"}},
+				},
+			},
+			{
+				Type: "terraform",
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "required_providers",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: ibm.DefaultProviderName}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	aliases := map[string]bool{}
+	known := true
+
+	for _, block := range content.Blocks {
+		switch block.Type {
+		case "provider":
+			if block.Labels[0] != ibm.DefaultProviderName {
+				continue
+			}
+			attr, exists := block.Body.Attributes["alias"]
+			if !exists {
+				continue
+			}
+			alias, aliasKnown, err := evaluateString(runner, attr)
+			if err != nil {
+				return nil, false, err
+			}
+			known = known && aliasKnown
+			aliases[alias] = true
+
+		case "terraform":
+			for _, requiredProviders := range block.Body.Blocks {
+				attr, exists := requiredProviders.Body.Attributes[ibm.DefaultProviderName]
+				if !exists {
+					continue
+				}
+				for _, alias := range configurationAliases(attr.Expr) {
+					aliases[alias] = true
+				}
+			}
+		}
+	}
+
+	return aliases, known, nil
+}
+
+// configurationAliases returns the aliases listed in `configuration_aliases`
+// of a `required_providers` entry, e.g. `eu` for `[ibm.eu]`
+func configurationAliases(expr hcl.Expression) []string {
+	pairs, diags := hcl.ExprMap(expr)
+	if diags.HasErrors() {
+		return nil
+	}
+
+	var aliases []string
+	for _, pair := range pairs {
+		if hcl.ExprAsKeyword(pair.Key) != "configuration_aliases" {
+			continue
+		}
+		exprs, diags := hcl.ExprList(pair.Value)
+		if diags.HasErrors() {
+			return nil
+		}
+		for _, expr := range exprs {
+			ref, diags := ibm.DecodeProviderConfigRef(expr, "configuration_aliases")
+			if !diags.HasErrors() && ref.Alias != "" {
+				aliases = append(aliases, ref.Alias)
+			}
+		}
+	}
+	return aliases
+}
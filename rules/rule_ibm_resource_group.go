@@ -0,0 +1,211 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// resourceGroupAttributes maps resource types that accept a resource group to the attribute that sets it
+var resourceGroupAttributes = map[string]string{
+	"ibm_container_vpc_cluster":        "resource_group_id",
+	"ibm_is_backup_policy":             "resource_group",
+	"ibm_is_bare_metal_server":         "resource_group",
+	"ibm_is_dedicated_host_group":      "resource_group",
+	"ibm_is_flow_log":                  "resource_group",
+	"ibm_is_floating_ip":               "resource_group",
+	"ibm_is_image":                     "resource_group",
+	"ibm_is_instance":                  "resource_group",
+	"ibm_is_instance_group":            "resource_group",
+	"ibm_is_instance_template":         "resource_group",
+	"ibm_is_lb":                        "resource_group",
+	"ibm_is_network_acl":               "resource_group",
+	"ibm_is_placement_group":           "resource_group",
+	"ibm_is_public_gateway":            "resource_group",
+	"ibm_is_security_group":            "resource_group",
+	"ibm_is_share":                     "resource_group",
+	"ibm_is_snapshot":                  "resource_group",
+	"ibm_is_ssh_key":                   "resource_group",
+	"ibm_is_subnet":                    "resource_group",
+	"ibm_is_virtual_endpoint_gateway":  "resource_group",
+	"ibm_is_virtual_network_interface": "resource_group",
+	"ibm_is_volume":                    "resource_group",
+	"ibm_is_vpc":                       "resource_group",
+	"ibm_is_vpn_gateway":               "resource_group",
+	"ibm_is_vpn_server":                "resource_group",
+	"ibm_resource_instance":            "resource_group_id",
+}
+
+// IBMResourceGroupRule checks that resources are placed in an explicit, allowed resource group
+type IBMResourceGroupRule struct {
+	tflint.DefaultRule
+}
+
+// resourceGroupConfig is the configuration of the `ibm_resource_group` rule
+type resourceGroupConfig struct {
+	// Allowed are the resource group IDs that literal values must be one of
+	Allowed []string `hclext:"allowed,optional"`
+	// RequireDataSource requires references to `data.ibm_resource_group`
+	RequireDataSource bool `hclext:"require_data_source,optional"`
+	// AllowMultiple allows the resources of a module to use different resource groups
+	AllowMultiple bool `hclext:"allow_multiple,optional"`
+}
+
+// resourceGroupUse is the resource group of a resource
+type resourceGroupUse struct {
+	key string
+	rng hcl.Range
+}
+
+// NewIBMResourceGroupRule returns a new rule
+func NewIBMResourceGroupRule() *IBMResourceGroupRule {
+	return &IBMResourceGroupRule{}
+}
+
+// Name returns the rule name
+func (r *IBMResourceGroupRule) Name() string {
+	return "ibm_resource_group"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMResourceGroupRule) Enabled() bool {
+	return false
+}
+
+// Severity returns the rule severity
+func (r *IBMResourceGroupRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *IBMResourceGroupRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMResourceGroupRule) Metadata() interface{} {
+	return Metadata{Category: "Resource Group", DeepCheck: DeepCheckNone}
+}
+
+// Check checks the resource group of every resource that accepts one
+func (r *IBMResourceGroupRule) Check(runner tflint.Runner) error {
+	config := &resourceGroupConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "resource_group"},
+						{Name: "resource_group_id"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	var uses []resourceGroupUse
+	for _, resource := range content.Blocks {
+		attrName, exists := resourceGroupAttributes[resource.Labels[0]]
+		if !exists {
+			continue
+		}
+
+		attr, exists := resource.Body.Attributes[attrName]
+		if !exists {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` should be specified; without it, the resource is created in the default resource group of the account", attrName),
+				resource.DefRange,
+			)
+			continue
+		}
+
+		if err := r.checkResourceGroup(runner, config, attr); err != nil {
+			return err
+		}
+
+		key, err := referenceKey(runner, attr)
+		if err != nil {
+			return err
+		}
+		if key != "" {
+			uses = append(uses, resourceGroupUse{key: key, rng: attr.Expr.Range()})
+		}
+	}
+
+	if !config.AllowMultiple {
+		r.checkSpread(runner, uses)
+	}
+	return nil
+}
+
+func (r *IBMResourceGroupRule) checkResourceGroup(runner tflint.Runner, config *resourceGroupConfig, attr *hclext.Attribute) error {
+	if config.RequireDataSource {
+		traversal, diags := hcl.AbsTraversalForExpr(attr.Expr)
+		if diags.HasErrors() || len(traversal) < 2 || traversal.RootName() != "data" || !isTraverseAttr(traversal[1], "ibm_resource_group") {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("`%s` must reference a `data.ibm_resource_group` data source", attr.Name),
+				attr.Expr.Range(),
+			)
+			return nil
+		}
+	}
+
+	if len(config.Allowed) == 0 {
+		return nil
+	}
+	return runner.EvaluateExpr(attr.Expr, func(id string) error {
+		if !slices.Contains(config.Allowed, id) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("resource group \"%s\" is not one of the allowed resource groups", id),
+				attr.Expr.Range(),
+			)
+		}
+		return nil
+	}, nil)
+}
+
+// checkSpread reports resources that use a different resource group than the
+// first resource of the module
+func (r *IBMResourceGroupRule) checkSpread(runner tflint.Runner, uses []resourceGroupUse) {
+	var groups []string
+	for _, use := range uses {
+		if !slices.Contains(groups, use.key) {
+			groups = append(groups, use.key)
+		}
+	}
+	if len(groups) < 2 {
+		return
+	}
+
+	for _, use := range uses {
+		if use.key == groups[0] {
+			continue
+		}
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("resource group %s differs from %s used by other resources in this module; set `allow_multiple = true` if this is intended", use.key, groups[0]),
+			use.rng,
+		)
+	}
+}
+
+func isTraverseAttr(step hcl.Traverser, name string) bool {
+	attr, ok := step.(hcl.TraverseAttr)
+	return ok && attr.Name == name
+}
@@ -0,0 +1,243 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+const (
+	// maxResourceNameLength is the maximum length of VPC resource names
+	maxResourceNameLength = 63
+	// defaultPlaceholderPattern matches a placeholder without a configured pattern
+	defaultPlaceholderPattern = "[a-z0-9]+"
+)
+
+var (
+	// resourceNameCharsPattern matches names with only valid characters
+	resourceNameCharsPattern = regexp.MustCompile(`^[a-z0-9-]*$`)
+	// placeholderPattern matches placeholders in templates, e.g. `${env}`
+	placeholderPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+// IBMResourceNamingRule checks the names of VPC resources against IBM Cloud
+// limits and configurable naming conventions
+type IBMResourceNamingRule struct {
+	tflint.DefaultRule
+}
+
+// resourceNamingConfig is the configuration of the `ibm_resource_naming` rule
+type resourceNamingConfig struct {
+	// Placeholders maps placeholder names to the regular expressions they match
+	Placeholders map[string]string      `hclext:"placeholders,optional"`
+	Formats      []resourceNamingFormat `hclext:"format,block"`
+}
+
+// resourceNamingFormat is the naming convention of a resource type.
+// Either a regular expression or a template such as `${env}-${app}-vpc` can be used.
+type resourceNamingFormat struct {
+	ResourceType string `hclext:"resource_type,label"`
+	Pattern      string `hclext:"pattern,optional"`
+	Template     string `hclext:"template,optional"`
+}
+
+// namingConvention is a compiled naming convention
+type namingConvention struct {
+	description string
+	regexp      *regexp.Regexp
+}
+
+// NewIBMResourceNamingRule returns a new rule
+func NewIBMResourceNamingRule() *IBMResourceNamingRule {
+	return &IBMResourceNamingRule{}
+}
+
+// Name returns the rule name
+func (r *IBMResourceNamingRule) Name() string {
+	return "ibm_resource_naming"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMResourceNamingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMResourceNamingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *IBMResourceNamingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMResourceNamingRule) Metadata() interface{} {
+	return Metadata{Category: "Naming", DeepCheck: DeepCheckNone}
+}
+
+// Check checks the `name` of every VPC resource
+func (r *IBMResourceNamingRule) Check(runner tflint.Runner) error {
+	config := &resourceNamingConfig{}
+	if err := runner.DecodeRuleConfig(r.Name(), config); err != nil {
+		return err
+	}
+	conventions, err := config.conventions()
+	if err != nil {
+		return err
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "name"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	// names maps resource types and literal names to the first resource using them
+	names := map[string]map[string]string{}
+
+	for _, resource := range content.Blocks {
+		resourceType := resource.Labels[0]
+		if !strings.HasPrefix(resourceType, "ibm_is_") {
+			continue
+		}
+		attr, exists := resource.Body.Attributes["name"]
+		if !exists {
+			continue
+		}
+
+		name, known, err := evaluateString(runner, attr)
+		if err != nil {
+			return err
+		}
+		if !known {
+			continue
+		}
+
+		if message := validateResourceName(name); message != "" {
+			// Names that are only invalid because of uppercase letters can be fixed
+			if lower := strings.ToLower(name); lower != name && validateResourceName(lower) == "" {
+				err = emitIssueWithStringFix(runner, r, message, attr.Expr, lower)
+			} else {
+				err = runner.EmitIssue(r, message, attr.Expr.Range())
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if convention, exists := conventions[resourceType]; exists && !convention.regexp.MatchString(name) {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("name \"%s\" does not match the naming convention %s for %s", name, convention.description, resourceType),
+				attr.Expr.Range(),
+			)
+		}
+
+		if names[resourceType] == nil {
+			names[resourceType] = map[string]string{}
+		}
+		if other, exists := names[resourceType][name]; exists {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("name \"%s\" is already used by %s", name, other),
+				attr.Expr.Range(),
+			)
+			continue
+		}
+		names[resourceType][name] = resourceAddress(resource)
+	}
+
+	return nil
+}
+
+// validateResourceName checks a name against the limits of the VPC API.
+// It returns an empty string for valid names.
+func validateResourceName(name string) string {
+	switch {
+	case len(name) > maxResourceNameLength:
+		return fmt.Sprintf("name \"%s\" is longer than %d characters", name, maxResourceNameLength)
+	case name == "" || name[0] < 'a' || name[0] > 'z':
+		return fmt.Sprintf("name \"%s\" must start with a lowercase letter", name)
+	case !resourceNameCharsPattern.MatchString(name):
+		return fmt.Sprintf("name \"%s\" must contain only lowercase letters, digits and hyphens", name)
+	case strings.HasSuffix(name, "-"):
+		return fmt.Sprintf("name \"%s\" must not end with a hyphen", name)
+	}
+	return ""
+}
+
+// conventions compiles the configured naming conventions by resource type
+func (c *resourceNamingConfig) conventions() (map[string]namingConvention, error) {
+	placeholders := map[string]string{}
+	for name, pattern := range c.Placeholders {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern for placeholder %s: %w", name, err)
+		}
+		placeholders[name] = pattern
+	}
+
+	conventions := map[string]namingConvention{}
+	for _, format := range c.Formats {
+		if (format.Pattern == "") == (format.Template == "") {
+			return nil, fmt.Errorf("format %q must set exactly one of pattern and template", format.ResourceType)
+		}
+
+		if format.Pattern != "" {
+			re, err := regexp.Compile(format.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern for %s: %w", format.ResourceType, err)
+			}
+			conventions[format.ResourceType] = namingConvention{
+				description: fmt.Sprintf("/%s/", format.Pattern),
+				regexp:      re,
+			}
+			continue
+		}
+
+		conventions[format.ResourceType] = namingConvention{
+			description: fmt.Sprintf("\"%s\"", format.Template),
+			regexp:      compileTemplate(format.Template, placeholders),
+		}
+	}
+	return conventions, nil
+}
+
+// compileTemplate converts a template such as `${env}-${app}-vpc` to a regular expression.
+// Placeholders match their configured pattern, or lowercase letters and digits by default.
+func compileTemplate(template string, placeholders map[string]string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+
+	last := 0
+	for _, match := range placeholderPattern.FindAllStringSubmatchIndex(template, -1) {
+		b.WriteString(regexp.QuoteMeta(template[last:match[0]]))
+
+		pattern, exists := placeholders[template[match[2]:match[3]]]
+		if !exists {
+			pattern = defaultPlaceholderPattern
+		}
+		b.WriteString("(?:" + pattern + ")")
+		last = match[1]
+	}
+	b.WriteString(regexp.QuoteMeta(template[last:]))
+	b.WriteString("$")
+
+	// Placeholder patterns were validated, so the result is always valid
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,11 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+func TestIBMResourceNamingRule(t *testing.T) {
+	ruletest.Run(t, NewIBMResourceNamingRule())
+}
@@ -0,0 +1,225 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// maxTagLength is the maximum length of a tag
+const maxTagLength = 128
+
+// tagCharsPattern matches tags with only valid characters
+var tagCharsPattern = regexp.MustCompile(`^[A-Za-z0-9 _\-.:]+$`)
+
+// taggableResourceTypes are the resource types that support tags.
+// Resources of other types are still checked when they set tags.
+var taggableResourceTypes = map[string]bool{
+	"ibm_container_vpc_cluster":        true,
+	"ibm_is_backup_policy":             true,
+	"ibm_is_bare_metal_server":         true,
+	"ibm_is_dedicated_host":            true,
+	"ibm_is_dedicated_host_group":      true,
+	"ibm_is_flow_log":                  true,
+	"ibm_is_floating_ip":               true,
+	"ibm_is_image":                     true,
+	"ibm_is_instance":                  true,
+	"ibm_is_instance_group":            true,
+	"ibm_is_instance_template":         true,
+	"ibm_is_lb":                        true,
+	"ibm_is_network_acl":               true,
+	"ibm_is_placement_group":           true,
+	"ibm_is_public_gateway":            true,
+	"ibm_is_security_group":            true,
+	"ibm_is_share":                     true,
+	"ibm_is_snapshot":                  true,
+	"ibm_is_ssh_key":                   true,
+	"ibm_is_subnet":                    true,
+	"ibm_is_virtual_endpoint_gateway":  true,
+	"ibm_is_virtual_network_interface": true,
+	"ibm_is_volume":                    true,
+	"ibm_is_vpc":                       true,
+	"ibm_is_vpn_gateway":               true,
+	"ibm_is_vpn_server":                true,
+	"ibm_resource_instance":            true,
+}
+
+// IBMResourceTaggingRule checks tags against IBM Cloud limits and the required tags policy
+type IBMResourceTaggingRule struct {
+	tflint.DefaultRule
+}
+
+// NewIBMResourceTaggingRule returns a new rule
+func NewIBMResourceTaggingRule() *IBMResourceTaggingRule {
+	return &IBMResourceTaggingRule{}
+}
+
+// Name returns the rule name
+func (r *IBMResourceTaggingRule) Name() string {
+	return "ibm_resource_tagging"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMResourceTaggingRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMResourceTaggingRule) Severity() tflint.Severity {
+	return tflint.WARNING
+}
+
+// Link returns the rule reference link
+func (r *IBMResourceTaggingRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMResourceTaggingRule) Metadata() interface{} {
+	return Metadata{Category: "Tagging", DeepCheck: DeepCheckNone}
+}
+
+// Check checks `tags` and `access_tags` of every IBM Cloud resource
+func (r *IBMResourceTaggingRule) Check(runner tflint.Runner) error {
+	var required []string
+	for _, key := range pluginConfig(runner).RequiredTags {
+		required = append(required, strings.ToLower(strings.TrimSuffix(key, ":")))
+	}
+
+	content, err := runner.GetModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{
+						{Name: "tags"},
+						{Name: "access_tags"},
+					},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range content.Blocks {
+		if !strings.HasPrefix(resource.Labels[0], "ibm_") {
+			continue
+		}
+
+		keys := map[string]bool{}
+		known := true
+		for _, name := range []string{"tags", "access_tags"} {
+			attr, exists := resource.Body.Attributes[name]
+			if !exists {
+				continue
+			}
+			attrKnown, err := r.checkTags(runner, attr, keys)
+			if err != nil {
+				return err
+			}
+			known = known && attrKnown
+		}
+
+		_, tagged := resource.Body.Attributes["tags"]
+		if !tagged && !taggableResourceTypes[resource.Labels[0]] {
+			continue
+		}
+		if !known {
+			continue
+		}
+
+		var missing []string
+		for _, key := range required {
+			if !keys[key] {
+				missing = append(missing, fmt.Sprintf("\"%s:\"", key))
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		rng := resource.DefRange
+		if tagged {
+			rng = resource.Body.Attributes["tags"].Expr.Range()
+		}
+		runner.EmitIssue(
+			r,
+			fmt.Sprintf("missing required tags: %s", strings.Join(missing, ", ")),
+			rng,
+		)
+	}
+
+	return nil
+}
+
+// checkTags validates each tag of the attribute at its own list element,
+// and adds the keys of the tags to keys. It returns false when the tags are unknown.
+func (r *IBMResourceTaggingRule) checkTags(runner tflint.Runner, attr *hclext.Attribute, keys map[string]bool) (bool, error) {
+	known := false
+	if err := runner.EvaluateExpr(attr.Expr, func([]string) error {
+		known = true
+		return nil
+	}, nil); err != nil {
+		return false, err
+	}
+	if !known {
+		return false, nil
+	}
+
+	// Tags are case insensitive
+	seen := map[string]bool{}
+	accessTags := attr.Name == "access_tags"
+
+	err := ibmRunner(runner).EachStringSliceExprs(attr.Expr, func(tag string, expr hcl.Expression) {
+		if message := validateTag(tag, accessTags); message != "" {
+			runner.EmitIssue(r, message, expr.Range())
+			return
+		}
+
+		normalized := strings.ToLower(tag)
+		if seen[normalized] {
+			runner.EmitIssue(
+				r,
+				fmt.Sprintf("duplicate tag \"%s\" in `%s`", tag, attr.Name),
+				expr.Range(),
+			)
+			return
+		}
+		seen[normalized] = true
+
+		// Only key:value tags provide a key, as a bare "env" tag is a label, not a value for "env"
+		if key, value, found := strings.Cut(normalized, ":"); found && strings.TrimSpace(value) != "" {
+			keys[strings.TrimSpace(key)] = true
+		}
+	})
+	return true, err
+}
+
+// validateTag checks a tag against the limits of the Global Search and Tagging API.
+// Access tags must be in the `key:value` format. It returns an empty string for valid tags.
+func validateTag(tag string, accessTag bool) string {
+	switch {
+	case tag == "":
+		return "tags cannot be empty"
+	case len(tag) > maxTagLength:
+		return fmt.Sprintf("tag \"%s\" is longer than %d characters", tag, maxTagLength)
+	case !tagCharsPattern.MatchString(tag):
+		return fmt.Sprintf("tag \"%s\" must contain only letters, digits, spaces, and the characters _ - . :", tag)
+	}
+
+	key, value, found := strings.Cut(tag, ":")
+	if found && (strings.TrimSpace(key) == "" || strings.TrimSpace(value) == "") {
+		return fmt.Sprintf("tag \"%s\" must be in the key:value format", tag)
+	}
+	if accessTag && !found {
+		return fmt.Sprintf("access tag \"%s\" must be in the key:value format", tag)
+	}
+	return ""
+}
@@ -0,0 +1,21 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+func TestIBMResourceTaggingRule(t *testing.T) {
+	ruletest.Run(t, NewIBMResourceTaggingRule())
+}
+
+func TestIBMResourceTaggingRule_requiredTags(t *testing.T) {
+	ruletest.Run(
+		t,
+		NewIBMResourceTaggingRule(),
+		ruletest.WithDir("testdata/ibm_resource_tagging_required_tags"),
+		ruletest.WithConfig(&ibm.Config{RequiredTags: []string{"env", "owner:", "cost-center"}}),
+	)
+}
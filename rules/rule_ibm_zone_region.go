@@ -0,0 +1,113 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+)
+
+// IBMZoneRegionRule checks that zones are known and belong to the provider region
+type IBMZoneRegionRule struct {
+	tflint.DefaultRule
+	resourceTypes []string
+}
+
+// NewIBMZoneRegionRule returns a new rule
+func NewIBMZoneRegionRule() *IBMZoneRegionRule {
+	return &IBMZoneRegionRule{
+		resourceTypes: []string{
+			"ibm_is_bare_metal_server",
+			"ibm_is_dedicated_host_group",
+			"ibm_is_floating_ip",
+			"ibm_is_instance",
+			"ibm_is_instance_template",
+			"ibm_is_public_gateway",
+			"ibm_is_reservation",
+			"ibm_is_share",
+			"ibm_is_subnet",
+			"ibm_is_volume",
+			"ibm_is_vpc_address_prefix",
+		},
+	}
+}
+
+// Name returns the rule name
+func (r *IBMZoneRegionRule) Name() string {
+	return "ibm_zone_region"
+}
+
+// Enabled returns whether the rule is enabled by default
+func (r *IBMZoneRegionRule) Enabled() bool {
+	return true
+}
+
+// Severity returns the rule severity
+func (r *IBMZoneRegionRule) Severity() tflint.Severity {
+	return tflint.ERROR
+}
+
+// Link returns the rule reference link
+func (r *IBMZoneRegionRule) Link() string {
+	return project.ReferenceLink(r.Name())
+}
+
+// Metadata returns the rule metadata
+func (r *IBMZoneRegionRule) Metadata() interface{} {
+	return Metadata{Category: "Zone", DeepCheck: DeepCheckNone}
+}
+
+// Check checks the zone of every zonal resource
+func (r *IBMZoneRegionRule) Check(runner tflint.Runner) error {
+	for _, resourceType := range r.resourceTypes {
+		resources, err := runner.GetResourceContent(resourceType, &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{
+				{Name: "zone"},
+				providerAttribute,
+			},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		for _, resource := range resources.Blocks {
+			attr, exists := resource.Body.Attributes["zone"]
+			if !exists {
+				continue
+			}
+
+			region, err := providerRegion(runner, resource)
+			if err != nil {
+				return err
+			}
+
+			err = runner.EvaluateExpr(attr.Expr, func(zone string) error {
+				zoneRegion, ok := ibm.RegionForZone(zone)
+				if !ok {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("\"%s\" is an invalid zone", zone),
+						attr.Expr.Range(),
+					)
+					return nil
+				}
+
+				if region != "" && zoneRegion != region {
+					runner.EmitIssue(
+						r,
+						fmt.Sprintf("zone %s does not belong to provider region %s", zone, region),
+						attr.Expr.Range(),
+					)
+				}
+				return nil
+			}, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
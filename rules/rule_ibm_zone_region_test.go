@@ -0,0 +1,15 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/uibm/tflint-ruleset-ibm/rules/ruletest"
+)
+
+func TestIBMZoneRegionRule(t *testing.T) {
+	// The region must only come from the test cases
+	for _, name := range []string{"IC_REGION", "IBMCLOUD_REGION"} {
+		t.Setenv(name, "")
+	}
+	ruletest.Run(t, NewIBMZoneRegionRule())
+}
@@ -0,0 +1,43 @@
+package rules
+
+import "github.com/terraform-linters/tflint-plugin-sdk/tflint"
+
+// Rules is a list of all rules
+var Rules = []tflint.Rule{
+	NewIBMIsBackupPolicyRule(),
+	NewIBMIsBackupPolicyPlanRule(),
+	NewIBMIsInstanceRule(),
+	NewIBMIsInstanceIPSpoofingRule(),
+	NewIBMIsNetworkACLRulesRule(),
+	NewIBMIsSecurityGroupRuleInvalidRule(),
+	NewIBMIsSecurityGroupRuleOpenIngressRule(),
+	NewIBMIsSSHKeyRule(),
+	NewIBMIsSubnetCIDRRule(),
+	NewIBMIsVolumeRule(),
+	NewIBMIsVPCRule(),
+	NewIBMProviderReferenceRule(),
+	NewIBMResourceGroupRule(),
+	NewIBMResourceNamingRule(),
+	NewIBMResourceTaggingRule(),
+	NewIBMZoneRegionRule(),
+}
+
+// DeepCheck describes whether a rule queries the IBM Cloud API
+type DeepCheck string
+
+const (
+	// DeepCheckNone is a rule that only performs static checks
+	DeepCheckNone DeepCheck = ""
+	// DeepCheckOptional is a rule that performs additional checks when `deep_check` is enabled
+	DeepCheckOptional DeepCheck = "optional"
+	// DeepCheckRequired is a rule that only reports issues when `deep_check` is enabled
+	DeepCheckRequired DeepCheck = "required"
+)
+
+// Metadata describes a rule in the generated documentation.
+// It is returned by the Metadata method of every rule.
+type Metadata struct {
+	// Category groups related rules in the rule index
+	Category  string
+	DeepCheck DeepCheck
+}
@@ -0,0 +1,141 @@
+package ruletest
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// localsRunner evaluates references to local values, which helper.TestRunner
+// does not support, so that rules evaluating `local.*` like `var.*` can be tested.
+// Local values that cannot be evaluated, such as references to resources, are unknown.
+type localsRunner struct {
+	*helper.Runner
+	ctx *hcl.EvalContext
+}
+
+var localsFileSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{Type: "variable", LabelNames: []string{"name"}},
+		{Type: "locals"},
+	},
+}
+
+var variableSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "default"}},
+}
+
+func newLocalsRunner(runner *helper.Runner, files map[string]string) (*localsRunner, error) {
+	parser := hclparse.NewParser()
+	variables := map[string]cty.Value{}
+	locals := map[string]*hcl.Attribute{}
+
+	for name, src := range files {
+		var (
+			file  *hcl.File
+			diags hcl.Diagnostics
+		)
+		switch {
+		case strings.HasSuffix(name, ".tf"):
+			file, diags = parser.ParseHCL([]byte(src), name)
+		case strings.HasSuffix(name, ".tf.json"):
+			file, diags = parser.ParseJSON([]byte(src), name)
+		default:
+			continue
+		}
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		content, _, diags := file.Body.PartialContent(localsFileSchema)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		for _, block := range content.Blocks {
+			switch block.Type {
+			case "variable":
+				variables[block.Labels[0]] = cty.DynamicVal
+				attrs, _, diags := block.Body.PartialContent(variableSchema)
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				if attr, exists := attrs.Attributes["default"]; exists {
+					val, diags := attr.Expr.Value(nil)
+					if diags.HasErrors() {
+						return nil, diags
+					}
+					variables[block.Labels[0]] = val
+				}
+			case "locals":
+				attrs, diags := block.Body.JustAttributes()
+				if diags.HasErrors() {
+					return nil, diags
+				}
+				for name, attr := range attrs {
+					locals[name] = attr
+				}
+			}
+		}
+	}
+
+	// Local values may refer to each other, so they are evaluated until no more
+	// can be resolved, and the rest are unknown
+	values := map[string]cty.Value{}
+	ctx := &hcl.EvalContext{Variables: map[string]cty.Value{"var": cty.ObjectVal(variables)}}
+	for resolved := true; resolved; {
+		resolved = false
+		ctx.Variables["local"] = cty.ObjectVal(values)
+		for name, attr := range locals {
+			if _, done := values[name]; done {
+				continue
+			}
+			if val, diags := attr.Expr.Value(ctx); !diags.HasErrors() {
+				values[name] = val
+				resolved = true
+			}
+		}
+	}
+	for name := range locals {
+		if _, done := values[name]; !done {
+			values[name] = cty.DynamicVal
+		}
+	}
+	ctx.Variables["local"] = cty.ObjectVal(values)
+
+	return &localsRunner{Runner: runner, ctx: ctx}, nil
+}
+
+// EvaluateExpr evaluates expressions referring to local values, and delegates
+// all other expressions to helper.Runner.
+func (r *localsRunner) EvaluateExpr(expr hcl.Expression, target interface{}, opts *tflint.EvaluateExprOption) error {
+	if !referencesLocals(expr) {
+		return r.Runner.EvaluateExpr(expr, target, opts)
+	}
+
+	val, diags := expr.Value(r.ctx)
+	if diags.HasErrors() {
+		return diags
+	}
+	if !val.IsWhollyKnown() {
+		// Like tflint, callbacks are not invoked for unknown values
+		if reflect.TypeOf(target).Kind() == reflect.Func {
+			return nil
+		}
+		return tflint.ErrUnknownValue
+	}
+	return r.Runner.EvaluateExpr(hcl.StaticExpr(val, expr.Range()), target, opts)
+}
+
+func referencesLocals(expr hcl.Expression) bool {
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() == "local" {
+			return true
+		}
+	}
+	return false
+}
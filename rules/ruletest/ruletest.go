@@ -0,0 +1,214 @@
+// Package ruletest runs rules against directories of Terraform files and
+// compares the issues they emit with golden files.
+//
+// Each test case is a subdirectory of testdata/<rule name> holding `.tf` files,
+// an optional `.tflint.hcl` with the rule configuration, and an `issues.golden`
+// file listing the expected issues, one per line:
+//
+//	main.tf:7,13-22: Error: "bc1-2x8" is a retired instance profile. Use "bx2-2x8" instead
+//
+// Files changed by fixes are compared with `<file>.fixed` golden files.
+// Unlike helper.TestRunner alone, references to local values are evaluated.
+// A rule is tested with a single call, and golden files are regenerated by
+// running the tests with -update:
+//
+//	func TestIBMIsInstanceRule(t *testing.T) {
+//		ruletest.Run(t, NewIBMIsInstanceRule())
+//	}
+//
+//	$ go test ./rules -run TestIBMIsInstanceRule -update
+package ruletest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/helper"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+const (
+	// issuesGolden is the golden file of the issues of a test case
+	issuesGolden = "issues.golden"
+	// fixedSuffix is the suffix of the golden files of fixed files
+	fixedSuffix = ".fixed"
+)
+
+// Option configures Run.
+type Option func(*options)
+
+type options struct {
+	dir    string
+	config *ibm.Config
+	client ibm.Client
+}
+
+// WithDir reads the test cases from dir instead of testdata/<rule name>.
+func WithDir(dir string) Option {
+	return func(o *options) { o.dir = dir }
+}
+
+// WithConfig sets the plugin configuration, e.g. `required_tags`, or a deep
+// check configuration pointing at an ibmtest.Server.
+func WithConfig(config *ibm.Config) Option {
+	return func(o *options) { o.config = config }
+}
+
+// WithClient enables deep checking with the given client for every provider configuration.
+func WithClient(client ibm.Client) Option {
+	return func(o *options) { o.client = client }
+}
+
+// Run runs the rule against every test case and compares the results with the golden files.
+func Run(t *testing.T, rule tflint.Rule, opts ...Option) {
+	t.Helper()
+
+	o := &options{dir: filepath.Join("testdata", rule.Name())}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	entries, err := os.ReadDir(o.dir)
+	if err != nil {
+		t.Fatalf("failed to read test cases: %s", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(o.dir, entry.Name())
+		t.Run(entry.Name(), func(t *testing.T) {
+			runCase(t, rule, dir, o)
+		})
+	}
+}
+
+func runCase(t *testing.T, rule tflint.Rule, dir string, o *options) {
+	t.Helper()
+
+	files, err := readFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner := helper.TestRunner(t, files)
+	locals, err := newLocalsRunner(runner, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ibmRunner *ibm.Runner
+	if o.client != nil {
+		ibmRunner, err = ibm.NewRunnerWithClient(locals, o.config, o.client)
+	} else {
+		ibmRunner, err = ibm.NewRunner(locals, o.config)
+	}
+	if err != nil {
+		t.Fatalf("failed to create runner: %s", err)
+	}
+
+	if err := rule.Check(ibmRunner); err != nil {
+		t.Fatalf("failed to check: %s", err)
+	}
+
+	compare(t, filepath.Join(dir, issuesGolden), formatIssues(runner.Issues))
+
+	changes := runner.Changes()
+	for name, src := range changes {
+		compare(t, filepath.Join(dir, name+fixedSuffix), src)
+	}
+
+	// Golden files of files that are no longer fixed are stale
+	stale, err := filepath.Glob(filepath.Join(dir, "*"+fixedSuffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range stale {
+		if _, exists := changes[strings.TrimSuffix(filepath.Base(path), fixedSuffix)]; exists {
+			continue
+		}
+		if *update {
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		t.Errorf("%s exists, but the file is not fixed", path)
+	}
+}
+
+// readFiles returns the Terraform files and the TFLint config of a test case
+func readFiles(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json") || name == ".tflint.hcl") {
+			continue
+		}
+		src, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		files[name] = string(src)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s has no Terraform files", dir)
+	}
+	return files, nil
+}
+
+// formatIssues formats issues as golden file lines, sorted by position
+func formatIssues(issues helper.Issues) []byte {
+	sorted := append(helper.Issues{}, issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].Range, sorted[j].Range
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Start.Byte != b.Start.Byte {
+			return a.Start.Byte < b.Start.Byte
+		}
+		return sorted[i].Message < sorted[j].Message
+	})
+
+	var b bytes.Buffer
+	for _, issue := range sorted {
+		fmt.Fprintf(&b, "%s: %s: %s\n", issue.Range, issue.Rule.Severity(), issue.Message)
+	}
+	return b.Bytes()
+}
+
+// compare compares got with the golden file at path, or overwrites the golden file with -update
+func compare(t *testing.T, path string, got []byte) {
+	t.Helper()
+
+	if *update {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("failed to read golden file: %s; run with -update to create it", err)
+		return
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("%s does not match (-want +got):\n%s", path, diff)
+	}
+}
@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+)
+
+// providerAttribute is added to resource schemas so that ibmClient can
+// resolve the provider configuration a resource uses.
+var providerAttribute = hclext.AttributeSchema{Name: "provider"}
+
+// ibmClient returns the IBM Cloud client for the provider configuration
+// used by the given resource. It returns nil when deep checking is disabled,
+// in which case rules skip their API-backed checks.
+func ibmClient(runner tflint.Runner, resource *hclext.Block) (ibm.Client, error) {
+	r, ok := runner.(*ibm.Runner)
+	if !ok || !r.DeepCheck() {
+		return nil, nil
+	}
+	return r.IBMClientFor(resource.Body.Attributes)
+}
+
+// providerRegion returns the region of the provider configuration used by the
+// given resource. It returns an empty string when the region cannot be
+// determined, e.g. when the runner is not an IBM Cloud runner.
+func providerRegion(runner tflint.Runner, resource *hclext.Block) (string, error) {
+	r, ok := runner.(*ibm.Runner)
+	if !ok {
+		return "", nil
+	}
+	return r.ProviderRegion(resource.Body.Attributes)
+}
+
+// pluginConfig returns the plugin configuration of the given runner.
+// It returns an empty configuration when the runner is not an IBM Cloud runner.
+func pluginConfig(runner tflint.Runner) *ibm.Config {
+	r, ok := runner.(*ibm.Runner)
+	if !ok || r.PluginConfig == nil {
+		return &ibm.Config{}
+	}
+	return r.PluginConfig
+}
+
+// ibmRunner returns the given runner as an IBM Cloud runner, wrapping it if needed,
+// so that helpers such as EachStringSliceExprs are available to all rules.
+func ibmRunner(runner tflint.Runner) *ibm.Runner {
+	if r, ok := runner.(*ibm.Runner); ok {
+		return r
+	}
+	return &ibm.Runner{Runner: runner}
+}
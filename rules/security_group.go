@@ -0,0 +1,208 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/terraform-linters/tflint-plugin-sdk/hclext"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+)
+
+// securityGroupPortSchema is the schema of `tcp` and `udp` blocks
+var securityGroupPortSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "port_min"},
+		{Name: "port_max"},
+	},
+}
+
+// securityGroupICMPSchema is the schema of `icmp` blocks
+var securityGroupICMPSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "type"},
+		{Name: "code"},
+	},
+}
+
+// securityGroupRuleSchema is the schema shared by `ibm_is_security_group_rule`
+// resources and inline `rules` blocks of `ibm_is_security_group`.
+// Newer provider versions set the protocol with top-level attributes instead of blocks.
+var securityGroupRuleSchema = &hclext.BodySchema{
+	Attributes: []hclext.AttributeSchema{
+		{Name: "group"},
+		{Name: "direction"},
+		{Name: "remote"},
+		{Name: "protocol"},
+		{Name: "port_min"},
+		{Name: "port_max"},
+		{Name: "type"},
+		{Name: "code"},
+	},
+	Blocks: []hclext.BlockSchema{
+		{Type: "tcp", Body: securityGroupPortSchema},
+		{Type: "udp", Body: securityGroupPortSchema},
+		{Type: "icmp", Body: securityGroupICMPSchema},
+	},
+}
+
+// sensitiveService is a service that should not be reachable from the internet
+type sensitiveService struct {
+	name string
+	port int
+}
+
+var sensitiveServices = []sensitiveService{
+	{name: "SSH", port: 22},
+	{name: "SQL Server", port: 1433},
+	{name: "Oracle", port: 1521},
+	{name: "MySQL", port: 3306},
+	{name: "RDP", port: 3389},
+	{name: "PostgreSQL", port: 5432},
+	{name: "Redis", port: 6379},
+	{name: "Elasticsearch", port: 9200},
+	{name: "MongoDB", port: 27017},
+}
+
+// securityGroupRule is a security group rule declared either as an
+// `ibm_is_security_group_rule` resource or as an inline `rules` block
+type securityGroupRule struct {
+	// group is the reference key of the security group, e.g. `ibm_is_security_group.example.id`
+	group    string
+	body     *hclext.BodyContent
+	defRange hcl.Range
+}
+
+// securityGroupRules returns all security group rules of the module
+func securityGroupRules(runner tflint.Runner) ([]securityGroupRule, error) {
+	var rules []securityGroupRule
+
+	groups, err := runner.GetResourceContent("ibm_is_security_group", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "rules", Body: securityGroupRuleSchema},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups.Blocks {
+		for _, block := range group.Body.Blocks {
+			rules = append(rules, securityGroupRule{
+				group:    resourceAddress(group) + ".id",
+				body:     block.Body,
+				defRange: block.DefRange,
+			})
+		}
+	}
+
+	resources, err := runner.GetResourceContent("ibm_is_security_group_rule", securityGroupRuleSchema, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range resources.Blocks {
+		rule := securityGroupRule{body: resource.Body, defRange: resource.DefRange}
+		if attr, exists := resource.Body.Attributes["group"]; exists {
+			if rule.group, err = referenceKey(runner, attr); err != nil {
+				return nil, err
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// protocol returns the protocol of the rule, or an empty string when it is unknown.
+// Rules without any protocol apply to all protocols.
+func (rule securityGroupRule) protocol(runner tflint.Runner) (string, error) {
+	if attr, exists := rule.body.Attributes["protocol"]; exists {
+		protocol, _, err := evaluateString(runner, attr)
+		return protocol, err
+	}
+	if len(rule.body.Blocks) > 0 {
+		return rule.body.Blocks[0].Type, nil
+	}
+	return "all", nil
+}
+
+// portBody returns the body that holds the port range of the rule
+func (rule securityGroupRule) portBody() *hclext.BodyContent {
+	for _, block := range rule.body.Blocks {
+		if block.Type == "tcp" || block.Type == "udp" {
+			return block.Body
+		}
+	}
+	return rule.body
+}
+
+// openIngress describes the sensitive services the rule exposes to the internet,
+// e.g. "SSH (22) from 0.0.0.0/0", and returns the range to report them at.
+// It returns an empty string when no sensitive service is exposed.
+func (rule securityGroupRule) openIngress(runner tflint.Runner) (string, hcl.Range, error) {
+	rng := rule.defRange
+
+	attr, exists := rule.body.Attributes["direction"]
+	if !exists {
+		return "", rng, nil
+	}
+	direction, _, err := evaluateString(runner, attr)
+	if err != nil || direction != "inbound" {
+		return "", rng, err
+	}
+
+	// Rules without a remote allow traffic from any source
+	source := "any source"
+	if attr, exists := rule.body.Attributes["remote"]; exists {
+		remote, _, err := evaluateString(runner, attr)
+		if err != nil {
+			return "", rng, err
+		}
+		if remote != "0.0.0.0/0" && remote != "::/0" {
+			return "", rng, nil
+		}
+		source = remote
+		rng = attr.Expr.Range()
+	}
+
+	protocol, err := rule.protocol(runner)
+	if err != nil {
+		return "", rng, err
+	}
+	switch protocol {
+	case "tcp", "all", "any", "icmp_tcp_udp":
+	default:
+		return "", rng, nil
+	}
+
+	portMin, portMax := minPort, maxPort
+	ports := rule.portBody()
+	for name, target := range map[string]*int{"port_min": &portMin, "port_max": &portMax} {
+		attr, exists := ports.Attributes[name]
+		if !exists {
+			continue
+		}
+		val, known, err := evaluateInt(runner, attr)
+		if err != nil {
+			return "", rng, err
+		}
+		if !known {
+			return "", rng, nil
+		}
+		*target = val
+	}
+
+	if portMin == minPort && portMax == maxPort {
+		return fmt.Sprintf("all ports from %s", source), rng, nil
+	}
+
+	var exposed []string
+	for _, service := range sensitiveServices {
+		if portMin <= service.port && service.port <= portMax {
+			exposed = append(exposed, fmt.Sprintf("%s (%d)", service.name, service.port))
+		}
+	}
+	if len(exposed) == 0 {
+		return "", rng, nil
+	}
+	return fmt.Sprintf("%s from %s", strings.Join(exposed, ", "), source), rng, nil
+}
@@ -0,0 +1,8 @@
+resource "ibm_is_backup_policy" "missing" {
+  name = "missing"
+}
+
+resource "ibm_is_backup_policy" "empty" {
+  name            = "empty"
+  match_user_tags = []
+}
@@ -0,0 +1,9 @@
+resource "ibm_is_backup_policy" "example" {
+  name            = "example-backup-policy"
+  match_user_tags = ["env:prod"]
+}
+
+resource "ibm_is_backup_policy_plan" "example" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0 2 * * *"
+}
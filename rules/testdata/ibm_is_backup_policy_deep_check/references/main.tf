@@ -0,0 +1,17 @@
+resource "ibm_is_backup_policy_plan" "existing" {
+  backup_policy_id = "r006-0fe9e5d8-0a4c-4818-96ec-e99ac3e4b8d1"
+  cron_spec        = "0 2 * * *"
+}
+
+resource "ibm_is_backup_policy_plan" "missing" {
+  backup_policy_id = "r006-00000000-0000-4000-8000-000000000000"
+  cron_spec        = "0 2 * * *"
+}
+
+data "ibm_is_backup_policy" "missing" {
+  identifier = "r006-00000000-0000-4000-8000-000000000001"
+}
+
+data "ibm_is_backup_policy_jobs" "missing" {
+  backup_policy_id = "r006-00000000-0000-4000-8000-000000000002"
+}
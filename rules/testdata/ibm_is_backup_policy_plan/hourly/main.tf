@@ -0,0 +1,14 @@
+resource "ibm_is_backup_policy_plan" "every_minute" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "* * * * *"
+}
+
+resource "ibm_is_backup_policy_plan" "twice_an_hour" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0,30 * * * *"
+}
+
+resource "ibm_is_backup_policy_plan" "hourly" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "15 * * * *"
+}
@@ -0,0 +1,24 @@
+resource "ibm_is_backup_policy_plan" "fields" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0 2 * *"
+}
+
+resource "ibm_is_backup_policy_plan" "hour" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0 24 * * *"
+}
+
+resource "ibm_is_backup_policy_plan" "name" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0 2 * * MON"
+}
+
+resource "ibm_is_backup_policy_plan" "range" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0 2 20-10 * *"
+}
+
+resource "ibm_is_backup_policy_plan" "step" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0 */0 * * *"
+}
@@ -0,0 +1,9 @@
+resource "ibm_is_backup_policy_plan" "example" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  cron_spec        = "0 2 * * *"
+
+  deletion_trigger {
+    delete_after      = 0
+    delete_over_count = "1000"
+  }
+}
@@ -0,0 +1,10 @@
+resource "ibm_is_backup_policy_plan" "daily" {
+  backup_policy_id = ibm_is_backup_policy.example.id
+  name             = "daily"
+  cron_spec        = "30 */2 * * 1-5"
+
+  deletion_trigger {
+    delete_after      = 30
+    delete_over_count = "10"
+  }
+}
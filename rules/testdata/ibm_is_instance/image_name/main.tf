@@ -0,0 +1,23 @@
+resource "ibm_is_instance" "name" {
+  name    = "name"
+  profile = "bx2-2x8"
+  image   = "ibm-ubuntu-22-04-5-minimal-amd64-1"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
+
+resource "ibm_is_instance" "invalid" {
+  name    = "invalid"
+  profile = "bx2-2x8"
+  image   = "invalid-image-id"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
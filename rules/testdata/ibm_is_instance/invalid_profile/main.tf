@@ -0,0 +1,51 @@
+variable "profile" {
+  default = "bx2-3x9"
+}
+
+resource "ibm_is_instance" "literal" {
+  name    = "literal"
+  profile = "invalid-profile"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
+
+resource "ibm_is_instance" "variable" {
+  name    = "variable"
+  profile = var.profile
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
+
+resource "ibm_is_instance" "empty" {
+  name    = "empty"
+  profile = ""
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
+
+resource "ibm_is_instance" "bare_metal" {
+  name    = "bare-metal"
+  profile = "bx2-metal-96x384"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
@@ -0,0 +1,8 @@
+resource "ibm_is_instance" "example" {
+  name = "example-instance"
+  vpc  = ibm_is_vpc.example.id
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
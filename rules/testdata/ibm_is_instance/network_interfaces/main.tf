@@ -0,0 +1,113 @@
+resource "ibm_is_subnet" "zone1" {
+  name = "example-subnet-1"
+  zone = "us-south-1"
+}
+
+resource "ibm_is_subnet" "zone2" {
+  name = "example-subnet-2"
+  zone = "us-south-2"
+}
+
+resource "ibm_is_instance" "mixed" {
+  name    = "mixed"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.zone1.id
+  }
+
+  primary_network_attachment {
+    name = "mixed-attachment"
+    virtual_network_interface {
+      subnet = ibm_is_subnet.zone1.id
+    }
+  }
+}
+
+resource "ibm_is_instance" "secondary_only" {
+  name    = "secondary-only"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  network_interfaces {
+    subnet = ibm_is_subnet.zone1.id
+  }
+}
+
+resource "ibm_is_instance" "none" {
+  name    = "none"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+}
+
+resource "ibm_is_instance" "template" {
+  name              = "template"
+  instance_template = ibm_is_instance_template.example.id
+}
+
+resource "ibm_is_instance" "missing_subnet" {
+  name    = "missing-subnet"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    security_groups = [ibm_is_security_group.example.id]
+  }
+}
+
+resource "ibm_is_instance" "attachments" {
+  name    = "attachments"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_attachment {
+    name = "existing-interface"
+    virtual_network_interface {
+      id = "r006-4b1c7a2e-93d5-4f60-8e21-7c5d9a3b6f10"
+    }
+  }
+
+  network_attachments {
+    name = "missing-interface"
+  }
+
+  network_attachments {
+    name = "missing-subnet"
+    virtual_network_interface {
+      name = "missing-subnet"
+    }
+  }
+}
+
+resource "ibm_is_instance" "wrong_zone" {
+  name    = "wrong-zone"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_attachment {
+    name = "primary"
+    virtual_network_interface {
+      subnet = ibm_is_subnet.zone1.id
+    }
+  }
+
+  network_attachments {
+    name = "secondary"
+    virtual_network_interface {
+      subnet = ibm_is_subnet.zone2.id
+    }
+  }
+}
@@ -0,0 +1,27 @@
+variable "profile" {
+  default = "cc1-2x4"
+}
+
+resource "ibm_is_instance" "literal" {
+  name    = "literal"
+  profile = "bc1-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
+
+resource "ibm_is_instance" "variable" {
+  name    = "variable"
+  profile = var.profile
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = ibm_is_vpc.example.id
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = ibm_is_subnet.example.id
+  }
+}
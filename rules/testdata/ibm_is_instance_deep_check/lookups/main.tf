@@ -0,0 +1,36 @@
+resource "ibm_is_instance" "existing" {
+  name    = "existing"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+  }
+}
+
+# The catalog knows cx2-2x4, but it is not available in the region
+resource "ibm_is_instance" "unavailable_profile" {
+  name    = "unavailable-profile"
+  profile = "cx2-2x4"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+  }
+}
+
+resource "ibm_is_instance" "missing_image_and_vpc" {
+  name    = "missing-image-and-vpc"
+  profile = "bx2-2x8"
+  image   = "r006-00000000-0000-4000-8000-000000000000"
+  vpc     = "r006-00000000-0000-4000-8000-000000000001"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+  }
+}
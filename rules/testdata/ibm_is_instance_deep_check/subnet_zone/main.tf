@@ -0,0 +1,51 @@
+resource "ibm_is_instance" "same_zone" {
+  name    = "same-zone"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+  }
+}
+
+resource "ibm_is_instance" "other_zone" {
+  name    = "other-zone"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = "0727-3f2e1d0c-9b8a-4766-8544-332211009988"
+  }
+}
+
+resource "ibm_is_instance" "missing_subnet" {
+  name    = "missing-subnet"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = "0717-00000000-0000-4000-8000-000000000000"
+  }
+}
+
+locals {
+  subnet_id = "0727-3f2e1d0c-9b8a-4766-8544-332211009988"
+}
+
+resource "ibm_is_instance" "local_subnet" {
+  name    = "local-subnet"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet = local.subnet_id
+  }
+}
@@ -0,0 +1,28 @@
+resource "ibm_is_instance" "router" {
+  name = "router"
+  zone = "us-south-1"
+
+  primary_network_interface {
+    subnet            = ibm_is_subnet.example.id
+    allow_ip_spoofing = true
+  }
+}
+
+resource "ibm_is_instance_template" "router" {
+  name = "router-template"
+  zone = "us-south-1"
+
+  primary_network_attachment {
+    name = "primary"
+    virtual_network_interface {
+      subnet            = ibm_is_subnet.example.id
+      allow_ip_spoofing = true
+    }
+  }
+}
+
+resource "ibm_is_virtual_network_interface" "router" {
+  name              = "router-interface"
+  subnet            = ibm_is_subnet.example.id
+  allow_ip_spoofing = true
+}
@@ -0,0 +1,13 @@
+resource "ibm_is_instance" "example" {
+  name = "example-instance"
+  zone = "us-south-1"
+
+  primary_network_interface {
+    subnet            = ibm_is_subnet.example.id
+    allow_ip_spoofing = false
+  }
+
+  network_interfaces {
+    subnet = ibm_is_subnet.example.id
+  }
+}
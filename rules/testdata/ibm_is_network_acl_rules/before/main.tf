@@ -0,0 +1,56 @@
+resource "ibm_is_network_acl" "example" {
+  name = "example-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+}
+
+# Placed before deny_ssh, which is declared later, so it is not shadowed
+resource "ibm_is_network_acl_rule" "allow_admin_ssh" {
+  network_acl = ibm_is_network_acl.example.id
+  before      = ibm_is_network_acl_rule.deny_ssh.rule_id
+  name        = "allow-admin-ssh"
+  action      = "allow"
+  direction   = "inbound"
+  source      = "10.0.0.0/8"
+  destination = "10.10.0.0/24"
+  tcp {
+    port_min = 22
+    port_max = 22
+  }
+}
+
+resource "ibm_is_network_acl_rule" "deny_ssh" {
+  network_acl = ibm_is_network_acl.example.id
+  name        = "deny-ssh"
+  action      = "deny"
+  direction   = "inbound"
+  source      = "0.0.0.0/0"
+  destination = "0.0.0.0/0"
+  tcp {
+    port_min = 22
+    port_max = 22
+  }
+}
+
+resource "ibm_is_network_acl_rule" "allow_outbound" {
+  network_acl = ibm_is_network_acl.example.id
+  name        = "allow-outbound"
+  action      = "allow"
+  direction   = "outbound"
+  source      = "0.0.0.0/0"
+  destination = "0.0.0.0/0"
+}
+
+# Placed after allow_admin_ssh, which matches all of its traffic
+resource "ibm_is_network_acl_rule" "allow_bastion_ssh" {
+  network_acl = ibm_is_network_acl.example.id
+  before      = ibm_is_network_acl_rule.allow_outbound.rule_id
+  name        = "allow-bastion-ssh"
+  action      = "allow"
+  direction   = "inbound"
+  source      = "10.20.0.4"
+  destination = "10.10.0.0/24"
+  tcp {
+    port_min = 22
+    port_max = 22
+  }
+}
@@ -0,0 +1,20 @@
+resource "ibm_is_network_acl" "example" {
+  name = "example-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    name        = "allow-all"
+    action      = "allow"
+    direction   = "inbound"
+    source      = "0.0.0.0/0"
+    destination = "0.0.0.0/0"
+  }
+
+  rules {
+    name        = "allow-all"
+    action      = "allow"
+    direction   = "outbound"
+    source      = "0.0.0.0/0"
+    destination = "0.0.0.0/0"
+  }
+}
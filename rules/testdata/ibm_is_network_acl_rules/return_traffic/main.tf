@@ -0,0 +1,41 @@
+resource "ibm_is_network_acl" "example" {
+  name = "example-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    name        = "allow-https-inbound"
+    action      = "allow"
+    direction   = "inbound"
+    source      = "0.0.0.0/0"
+    destination = "10.10.0.0/24"
+    tcp {
+      port_min = 443
+      port_max = 443
+    }
+  }
+
+  rules {
+    name        = "deny-outbound"
+    action      = "deny"
+    direction   = "outbound"
+    source      = "0.0.0.0/0"
+    destination = "0.0.0.0/0"
+  }
+}
+
+resource "ibm_is_network_acl" "no_outbound" {
+  name = "no-outbound-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    name        = "allow-dns-inbound"
+    action      = "allow"
+    direction   = "inbound"
+    source      = "10.0.0.0/8"
+    destination = "10.10.0.0/24"
+    udp {
+      port_min = 53
+      port_max = 53
+    }
+  }
+}
@@ -0,0 +1,44 @@
+resource "ibm_is_network_acl" "example" {
+  name = "example-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    name        = "deny-inbound"
+    action      = "deny"
+    direction   = "inbound"
+    source      = "0.0.0.0/0"
+    destination = "0.0.0.0/0"
+  }
+
+  rules {
+    name        = "allow-ssh"
+    action      = "allow"
+    direction   = "inbound"
+    source      = "10.0.0.0/8"
+    destination = "10.10.0.0/24"
+    tcp {
+      port_min = 22
+      port_max = 22
+    }
+  }
+
+  rules {
+    name        = "allow-outbound"
+    action      = "allow"
+    direction   = "outbound"
+    source      = "0.0.0.0/0"
+    destination = "0.0.0.0/0"
+  }
+
+  rules {
+    name        = "allow-dns"
+    action      = "allow"
+    direction   = "outbound"
+    source      = "10.10.0.0/24"
+    destination = "161.26.0.10"
+    udp {
+      port_min = 53
+      port_max = 53
+    }
+  }
+}
@@ -0,0 +1,51 @@
+resource "ibm_is_network_acl" "example" {
+  name = "example-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+}
+
+resource "ibm_is_network_acl" "other" {
+  name = "other-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+}
+
+resource "ibm_is_network_acl_rule" "other_deny" {
+  network_acl = ibm_is_network_acl.other.id
+  name        = "deny-all"
+  action      = "deny"
+  direction   = "inbound"
+  source      = "0.0.0.0/0"
+  destination = "0.0.0.0/0"
+}
+
+resource "ibm_is_network_acl_rule" "allow_outbound" {
+  network_acl = ibm_is_network_acl.example.id
+  name        = "allow-outbound"
+  action      = "allow"
+  direction   = "outbound"
+  source      = "0.0.0.0/0"
+  destination = "0.0.0.0/0"
+}
+
+resource "ibm_is_network_acl_rule" "wrong_acl" {
+  network_acl = ibm_is_network_acl.example.id
+  before      = ibm_is_network_acl_rule.other_deny.rule_id
+  name        = "allow-inbound"
+  action      = "allow"
+  direction   = "inbound"
+  source      = "10.0.0.0/8"
+  destination = "0.0.0.0/0"
+}
+
+resource "ibm_is_network_acl_rule" "undeclared" {
+  network_acl = ibm_is_network_acl.example.id
+  before      = ibm_is_network_acl_rule.deny_all.rule_id
+  name        = "allow-https"
+  action      = "allow"
+  direction   = "inbound"
+  source      = "0.0.0.0/0"
+  destination = "10.10.0.0/24"
+  tcp {
+    port_min = 443
+    port_max = 443
+  }
+}
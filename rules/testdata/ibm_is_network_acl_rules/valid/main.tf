@@ -0,0 +1,36 @@
+resource "ibm_is_network_acl" "example" {
+  name = "example-acl"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    name        = "allow-https-inbound"
+    action      = "allow"
+    direction   = "inbound"
+    source      = "0.0.0.0/0"
+    destination = "10.10.0.0/24"
+    tcp {
+      port_min = 443
+      port_max = 443
+    }
+  }
+
+  rules {
+    name        = "allow-https-outbound"
+    action      = "allow"
+    direction   = "outbound"
+    source      = "10.10.0.0/24"
+    destination = "0.0.0.0/0"
+    tcp {
+      source_port_min = 443
+      source_port_max = 443
+    }
+  }
+
+  rules {
+    name        = "deny-inbound"
+    action      = "deny"
+    direction   = "inbound"
+    source      = "0.0.0.0/0"
+    destination = "0.0.0.0/0"
+  }
+}
@@ -0,0 +1,30 @@
+resource "ibm_is_security_group" "example" {
+  name = "example-sg"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    direction = "inbound"
+    remote    = "10.0.0.0/8"
+    icmp {
+      type = 255
+      code = 256
+    }
+  }
+}
+
+resource "ibm_is_security_group_rule" "code_without_type" {
+  group     = ibm_is_security_group.example.id
+  direction = "inbound"
+  remote    = "10.0.0.0/8"
+  icmp {
+    code = 0
+  }
+}
+
+resource "ibm_is_security_group_rule" "protocol_attributes" {
+  group     = ibm_is_security_group.example.id
+  direction = "inbound"
+  remote    = "10.0.0.0/8"
+  protocol  = "icmp"
+  type      = -1
+}
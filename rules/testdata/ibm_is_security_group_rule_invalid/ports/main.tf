@@ -0,0 +1,33 @@
+variable "port" {
+  type    = number
+  default = 70000
+}
+
+resource "ibm_is_security_group_rule" "out_of_range" {
+  group     = "r006-2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+  direction = "inbound"
+  remote    = "10.0.0.0/8"
+  tcp {
+    port_min = 0
+    port_max = var.port
+  }
+}
+
+resource "ibm_is_security_group_rule" "reversed" {
+  group     = "r006-2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+  direction = "inbound"
+  remote    = "10.0.0.0/8"
+  udp {
+    port_min = 8080
+    port_max = 8000
+  }
+}
+
+resource "ibm_is_security_group_rule" "protocol_attributes" {
+  group     = "r006-2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+  direction = "inbound"
+  remote    = "10.0.0.0/8"
+  protocol  = "tcp"
+  port_min  = 443
+  port_max  = 80
+}
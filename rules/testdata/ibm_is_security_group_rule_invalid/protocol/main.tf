@@ -0,0 +1,28 @@
+resource "ibm_is_security_group" "example" {
+  name = "example-sg"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    direction = "inbound"
+    remote    = "10.0.0.0/8"
+    tcp {
+      port_min = 80
+      port_max = 80
+    }
+    udp {
+      port_min = 80
+      port_max = 80
+    }
+  }
+}
+
+resource "ibm_is_security_group_rule" "protocol_and_block" {
+  group     = ibm_is_security_group.example.id
+  direction = "inbound"
+  remote    = "10.0.0.0/8"
+  protocol  = "tcp"
+  tcp {
+    port_min = 22
+    port_max = 22
+  }
+}
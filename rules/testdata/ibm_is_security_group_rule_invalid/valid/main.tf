@@ -0,0 +1,36 @@
+resource "ibm_is_security_group" "example" {
+  name = "example-sg"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    direction = "inbound"
+    remote    = "10.0.0.0/8"
+    tcp {
+      port_min = 443
+      port_max = 443
+    }
+  }
+}
+
+resource "ibm_is_security_group_rule" "icmp" {
+  group     = ibm_is_security_group.example.id
+  direction = "inbound"
+  remote    = "10.0.0.0/8"
+  icmp {
+    type = 8
+    code = 0
+  }
+}
+
+resource "ibm_is_security_group_rule" "udp" {
+  group     = ibm_is_security_group.example.id
+  direction = "outbound"
+  protocol  = "udp"
+  port_min  = 53
+  port_max  = 53
+}
+
+resource "ibm_is_security_group_rule" "all" {
+  group     = ibm_is_security_group.example.id
+  direction = "outbound"
+}
@@ -0,0 +1,47 @@
+resource "ibm_is_security_group" "open" {
+  name = "open-sg"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+}
+
+resource "ibm_is_security_group_rule" "rdp" {
+  group     = ibm_is_security_group.open.id
+  direction = "inbound"
+  remote    = "0.0.0.0/0"
+  tcp {
+    port_min = 3389
+    port_max = 3389
+  }
+}
+
+resource "ibm_is_security_group" "private" {
+  name = "private-sg"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    direction = "inbound"
+    remote    = "10.0.0.0/8"
+  }
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example-instance"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  profile = "bx2-2x8"
+  vpc     = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet          = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+    security_groups = [ibm_is_security_group.private.id, ibm_is_security_group.open.id]
+  }
+
+  network_interfaces {
+    subnet          = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+    security_groups = [ibm_is_security_group.private.id]
+  }
+
+  network_interfaces {
+    subnet          = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+    security_groups = [ibm_is_security_group.open.id]
+  }
+}
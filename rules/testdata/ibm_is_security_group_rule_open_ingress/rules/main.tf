@@ -0,0 +1,37 @@
+resource "ibm_is_security_group" "example" {
+  name = "example-sg"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  rules {
+    direction = "inbound"
+    remote    = "0.0.0.0/0"
+    tcp {
+      port_min = 22
+      port_max = 22
+    }
+  }
+
+  # Rules without a remote allow any source
+  rules {
+    direction = "inbound"
+  }
+}
+
+resource "ibm_is_security_group_rule" "databases" {
+  group     = ibm_is_security_group.example.id
+  direction = "inbound"
+  remote    = "::/0"
+  protocol  = "tcp"
+  port_min  = 3000
+  port_max  = 6000
+}
+
+resource "ibm_is_security_group_rule" "all_ports" {
+  group     = ibm_is_security_group.example.id
+  direction = "inbound"
+  remote    = "0.0.0.0/0"
+  tcp {
+    port_min = 1
+    port_max = 65535
+  }
+}
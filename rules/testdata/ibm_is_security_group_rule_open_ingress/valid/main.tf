@@ -0,0 +1,54 @@
+resource "ibm_is_security_group" "example" {
+  name = "example-sg"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+
+  # Sensitive services from private networks
+  rules {
+    direction = "inbound"
+    remote    = "10.0.0.0/8"
+    tcp {
+      port_min = 22
+      port_max = 22
+    }
+  }
+
+  # Other services from the internet
+  rules {
+    direction = "inbound"
+    remote    = "0.0.0.0/0"
+    tcp {
+      port_min = 443
+      port_max = 443
+    }
+  }
+}
+
+# Outbound rules and UDP are not checked
+resource "ibm_is_security_group_rule" "outbound" {
+  group     = ibm_is_security_group.example.id
+  direction = "outbound"
+  remote    = "0.0.0.0/0"
+}
+
+resource "ibm_is_security_group_rule" "dns" {
+  group     = ibm_is_security_group.example.id
+  direction = "inbound"
+  remote    = "0.0.0.0/0"
+  udp {
+    port_min = 53
+    port_max = 53
+  }
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example-instance"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  profile = "bx2-2x8"
+  vpc     = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone    = "us-south-1"
+
+  primary_network_interface {
+    subnet          = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+    security_groups = [ibm_is_security_group.example.id]
+  }
+}
@@ -0,0 +1,41 @@
+data "ibm_is_image" "ubuntu" {
+  name = "ibm-ubuntu-24-04-6-minimal-amd64-1"
+}
+
+data "ibm_is_image" "windows" {
+  name = "ibm-windows-server-2022-full-standard-amd64-16"
+}
+
+resource "ibm_is_ssh_key" "ed25519" {
+  name       = "example-ed25519"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKBGolLWrCcE2whMu9j+aT3OG4S8qjen1zAh9OEZ2Vhf demo"
+  type       = "ed25519"
+}
+
+resource "ibm_is_instance" "no_keys" {
+  name    = "example-no-keys"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+}
+
+resource "ibm_is_instance" "empty_keys" {
+  name      = "example-empty-keys"
+  image     = data.ibm_is_image.ubuntu.id
+  profile   = "bx2-2x8"
+  keys      = []
+  user_data = "#cloud-config\npackage_update: true\n"
+}
+
+resource "ibm_is_instance" "key_name" {
+  name    = "example-key-name"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+  keys    = ["example-key"]
+}
+
+resource "ibm_is_instance" "windows" {
+  name    = "example-windows"
+  image   = data.ibm_is_image.windows.id
+  profile = "bx2-2x8"
+  keys    = [ibm_is_ssh_key.ed25519.id]
+}
@@ -0,0 +1,54 @@
+data "ibm_is_image" "ubuntu" {
+  name = "ibm-ubuntu-24-04-6-minimal-amd64-1"
+}
+
+resource "ibm_is_ssh_key" "rsa" {
+  name       = "example-rsa"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC1eaufDRs5tJSC0SuzryOP64K70cNXI8ZkTnUmoVsvM4AiUdQPxwR7OpzCdJ7nVGNcbOx03xWU4OOjq6OBROm7L35Sz/rHPnSGG9wC/6n7dBPxkE3CRVFybTwi+rwpsC8E75PPU3cqJk27/8CHdjT3IpmAd7IuC660oLl1D/HlcmGXbrq1e1qaMbhUSMNBvlJYg1cVi1E7k3gEbAIdviqH2hehcp/Nddn1642L4hyQi4U/ypgSH7K6jfFRZs/adLHAvR8Gt85AETwL/mq63bG2sP6NnslMrBKpL32ChdJQ5A8S15myP4NcRQ9m371pHwRTiQ58A2l7oAo6yX2aG94j demo"
+}
+
+locals {
+  key_name    = "example-key"
+  key_id      = "r006-7d6c5b4a-3928-4716-8a5b-4c3d2e1f0a9b"
+  key_names   = ["example-key-a", "example-key-b"]
+  managed_key = ibm_is_ssh_key.rsa.id
+}
+
+resource "ibm_is_instance" "local_key_name" {
+  name    = "example-local-key-name"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+  keys    = [local.key_name]
+}
+
+resource "ibm_is_instance" "local_key_names" {
+  name    = "example-local-key-names"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+  keys    = local.key_names
+}
+
+resource "ibm_is_instance" "local_key_id" {
+  name    = "example-local-key-id"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+  keys    = [local.key_id]
+}
+
+# Local values referring to managed keys are unknown at lint time
+resource "ibm_is_instance" "local_managed_key" {
+  name    = "example-local-managed-key"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+  keys    = [local.managed_key]
+}
+
+locals {
+  image = "ibm-ubuntu-24-04-6-minimal-amd64-1"
+}
+
+resource "ibm_is_instance" "local_image" {
+  name    = "example-local-image"
+  image   = local.image
+  profile = "bx2-2x8"
+}
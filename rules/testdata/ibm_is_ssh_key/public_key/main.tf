@@ -0,0 +1,20 @@
+resource "ibm_is_ssh_key" "truncated" {
+  name       = "example-truncated"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2E demo"
+}
+
+resource "ibm_is_ssh_key" "ecdsa" {
+  name       = "example-ecdsa"
+  public_key = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBCctjMED0KezXEhaqHpX9KYi4Ldymw+GdCuzB149jnamoGuMqulg5eU5IZoqRKAcgxrio9uByEZhaCSi9aLaPH4= demo"
+}
+
+resource "ibm_is_ssh_key" "missing_type" {
+  name       = "example-missing-type"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKBGolLWrCcE2whMu9j+aT3OG4S8qjen1zAh9OEZ2Vhf demo"
+}
+
+resource "ibm_is_ssh_key" "dsa" {
+  name       = "example-dsa"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC1eaufDRs5tJSC0SuzryOP64K70cNXI8ZkTnUmoVsvM4AiUdQPxwR7OpzCdJ7nVGNcbOx03xWU4OOjq6OBROm7L35Sz/rHPnSGG9wC/6n7dBPxkE3CRVFybTwi+rwpsC8E75PPU3cqJk27/8CHdjT3IpmAd7IuC660oLl1D/HlcmGXbrq1e1qaMbhUSMNBvlJYg1cVi1E7k3gEbAIdviqH2hehcp/Nddn1642L4hyQi4U/ypgSH7K6jfFRZs/adLHAvR8Gt85AETwL/mq63bG2sP6NnslMrBKpL32ChdJQ5A8S15myP4NcRQ9m371pHwRTiQ58A2l7oAo6yX2aG94j demo"
+  type       = "dsa"
+}
@@ -0,0 +1,43 @@
+data "ibm_is_image" "ubuntu" {
+  name = "ibm-ubuntu-24-04-6-minimal-amd64-1"
+}
+
+data "ibm_is_image" "windows" {
+  name = "ibm-windows-server-2022-full-standard-amd64-16"
+}
+
+resource "ibm_is_ssh_key" "rsa" {
+  name       = "example-rsa"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC1eaufDRs5tJSC0SuzryOP64K70cNXI8ZkTnUmoVsvM4AiUdQPxwR7OpzCdJ7nVGNcbOx03xWU4OOjq6OBROm7L35Sz/rHPnSGG9wC/6n7dBPxkE3CRVFybTwi+rwpsC8E75PPU3cqJk27/8CHdjT3IpmAd7IuC660oLl1D/HlcmGXbrq1e1qaMbhUSMNBvlJYg1cVi1E7k3gEbAIdviqH2hehcp/Nddn1642L4hyQi4U/ypgSH7K6jfFRZs/adLHAvR8Gt85AETwL/mq63bG2sP6NnslMrBKpL32ChdJQ5A8S15myP4NcRQ9m371pHwRTiQ58A2l7oAo6yX2aG94j demo"
+}
+
+resource "ibm_is_ssh_key" "ed25519" {
+  name       = "example-ed25519"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKBGolLWrCcE2whMu9j+aT3OG4S8qjen1zAh9OEZ2Vhf demo"
+  type       = "ed25519"
+}
+
+resource "ibm_is_instance" "linux" {
+  name    = "example-linux"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+  keys    = [ibm_is_ssh_key.ed25519.id, "r006-14140f94-fcc4-11e9-96e7-a72723715315"]
+}
+
+resource "ibm_is_instance" "windows" {
+  name    = "example-windows"
+  image   = data.ibm_is_image.windows.id
+  profile = "bx2-2x8"
+  keys    = [ibm_is_ssh_key.rsa.id]
+}
+
+resource "ibm_is_instance" "cloud_init" {
+  name      = "example-cloud-init"
+  image     = data.ibm_is_image.ubuntu.id
+  profile   = "bx2-2x8"
+  user_data = <<-EOT
+    #cloud-config
+    ssh_authorized_keys:
+      - ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKBGolLWrCcE2whMu9j+aT3OG4S8qjen1zAh9OEZ2Vhf demo
+  EOT
+}
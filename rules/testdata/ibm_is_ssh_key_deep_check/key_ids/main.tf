@@ -0,0 +1,18 @@
+variable "ssh_keys" {
+  type    = list(string)
+  default = ["r006-6a1b2c3d-1111-4e5f-8a9b-0c1d2e3f4a5b"]
+}
+
+resource "ibm_is_instance" "existing" {
+  name    = "example-existing"
+  image   = "r006-14140f94-fcc4-11e9-96e7-a72723715315"
+  profile = "bx2-2x8"
+  keys    = ["r006-0f1e2d3c-4b5a-4697-8877-665544332211"]
+}
+
+resource "ibm_is_instance" "missing" {
+  name    = "example-missing"
+  image   = "r006-14140f94-fcc4-11e9-96e7-a72723715315"
+  profile = "bx2-2x8"
+  keys    = var.ssh_keys
+}
@@ -0,0 +1,29 @@
+data "ibm_is_image" "windows" {
+  name = "ibm-windows-server-2022-full-standard-amd64-16"
+}
+
+data "ibm_is_image" "ubuntu" {
+  name = "ibm-ubuntu-24-04-6-minimal-amd64-1"
+}
+
+resource "ibm_is_instance" "windows_ed25519" {
+  name    = "example-windows-ed25519"
+  image   = data.ibm_is_image.windows.id
+  profile = "bx2-2x8"
+  keys    = ["r006-0f1e2d3c-4b5a-4697-8877-665544332211"]
+}
+
+resource "ibm_is_instance" "windows_rsa" {
+  name    = "example-windows-rsa"
+  image   = data.ibm_is_image.windows.id
+  profile = "bx2-2x8"
+  keys    = ["r006-7d6c5b4a-3928-4716-8a5b-4c3d2e1f0a9b"]
+}
+
+# Linux images support Ed25519 keys
+resource "ibm_is_instance" "linux_ed25519" {
+  name    = "example-linux-ed25519"
+  image   = data.ibm_is_image.ubuntu.id
+  profile = "bx2-2x8"
+  keys    = ["r006-0f1e2d3c-4b5a-4697-8877-665544332211"]
+}
@@ -0,0 +1,33 @@
+variable "address_count" {
+  type    = number
+  default = 100
+}
+
+resource "ibm_is_subnet" "both" {
+  name                     = "both-subnet"
+  vpc                      = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "us-south-1"
+  ipv4_cidr_block          = "10.240.0.0/24"
+  total_ipv4_address_count = 256
+}
+
+resource "ibm_is_subnet" "too_small" {
+  name                     = "too-small-subnet"
+  vpc                      = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "us-south-1"
+  total_ipv4_address_count = 4
+}
+
+resource "ibm_is_subnet" "not_power_of_two" {
+  name                     = "not-power-of-two-subnet"
+  vpc                      = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "us-south-1"
+  total_ipv4_address_count = var.address_count
+}
+
+resource "ibm_is_subnet" "valid" {
+  name                     = "valid-subnet"
+  vpc                      = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "us-south-2"
+  total_ipv4_address_count = 8
+}
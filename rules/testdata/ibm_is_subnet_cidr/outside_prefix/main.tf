@@ -0,0 +1,40 @@
+resource "ibm_is_vpc" "example" {
+  name                      = "example-vpc"
+  address_prefix_management = "manual"
+}
+
+resource "ibm_is_vpc_address_prefix" "zone1" {
+  name = "zone1-prefix"
+  vpc  = ibm_is_vpc.example.id
+  zone = "us-south-1"
+  cidr = "10.10.0.0/18"
+}
+
+resource "ibm_is_vpc_address_prefix" "zone2" {
+  name = "zone2-prefix"
+  vpc  = ibm_is_vpc.example.id
+  zone = "us-south-2"
+  cidr = "10.10.64.0/18"
+}
+
+resource "ibm_is_subnet" "outside" {
+  name            = "outside-subnet"
+  vpc             = ibm_is_vpc.example.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.20.0.0/24"
+}
+
+# The prefix of another zone does not contain subnets of this zone
+resource "ibm_is_subnet" "wrong_zone" {
+  name            = "wrong-zone-subnet"
+  vpc             = ibm_is_vpc.example.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.64.0/24"
+}
+
+resource "ibm_is_subnet" "no_prefix" {
+  name            = "no-prefix-subnet"
+  vpc             = ibm_is_vpc.example.id
+  zone            = "us-south-3"
+  ipv4_cidr_block = "10.10.128.0/24"
+}
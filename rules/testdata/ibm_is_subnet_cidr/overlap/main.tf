@@ -0,0 +1,43 @@
+resource "ibm_is_vpc" "example" {
+  name = "example-vpc"
+}
+
+resource "ibm_is_vpc" "other" {
+  name = "other-vpc"
+}
+
+resource "ibm_is_vpc_address_prefix" "first" {
+  name = "first-prefix"
+  vpc  = ibm_is_vpc.example.id
+  zone = "us-south-1"
+  cidr = "10.10.0.0/18"
+}
+
+resource "ibm_is_vpc_address_prefix" "second" {
+  name = "second-prefix"
+  vpc  = ibm_is_vpc.example.id
+  zone = "us-south-2"
+  cidr = "10.10.32.0/19"
+}
+
+resource "ibm_is_subnet" "app" {
+  name            = "app-subnet"
+  vpc             = ibm_is_vpc.example.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.0.0/24"
+}
+
+resource "ibm_is_subnet" "db" {
+  name            = "db-subnet"
+  vpc             = ibm_is_vpc.example.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.0.128/25"
+}
+
+# The same range in another VPC does not overlap
+resource "ibm_is_subnet" "other" {
+  name            = "other-subnet"
+  vpc             = ibm_is_vpc.other.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.0.0/24"
+}
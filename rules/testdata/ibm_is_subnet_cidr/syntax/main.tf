@@ -0,0 +1,41 @@
+resource "ibm_is_subnet" "invalid" {
+  name            = "invalid-subnet"
+  vpc             = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.0.0"
+}
+
+resource "ibm_is_subnet" "ipv6" {
+  name            = "ipv6-subnet"
+  vpc             = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone            = "us-south-1"
+  ipv4_cidr_block = "fd00::/64"
+}
+
+resource "ibm_is_subnet" "too_small" {
+  name            = "too-small-subnet"
+  vpc             = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.0.0/30"
+}
+
+resource "ibm_is_subnet" "host_bits" {
+  name            = "host-bits-subnet"
+  vpc             = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.1.1/24"
+}
+
+resource "ibm_is_vpc_address_prefix" "reserved" {
+  name = "reserved-prefix"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone = "us-south-1"
+  cidr = "166.8.0.0/16"
+}
+
+resource "ibm_is_vpc_address_prefix" "too_large" {
+  name = "too-large-prefix"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone = "us-south-1"
+  cidr = "10.0.0.0/8"
+}
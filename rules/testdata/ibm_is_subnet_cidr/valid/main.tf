@@ -0,0 +1,51 @@
+resource "ibm_is_vpc" "manual" {
+  name                      = "manual-vpc"
+  address_prefix_management = "manual"
+}
+
+resource "ibm_is_vpc_address_prefix" "zone1" {
+  name = "zone1-prefix"
+  vpc  = ibm_is_vpc.manual.id
+  zone = "us-south-1"
+  cidr = "10.10.0.0/18"
+}
+
+resource "ibm_is_vpc_address_prefix" "zone2" {
+  name = "zone2-prefix"
+  vpc  = ibm_is_vpc.manual.id
+  zone = "us-south-2"
+  cidr = "10.10.64.0/18"
+}
+
+resource "ibm_is_subnet" "app" {
+  name            = "app-subnet"
+  vpc             = ibm_is_vpc.manual.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.10.0.0/24"
+}
+
+resource "ibm_is_subnet" "db" {
+  name            = "db-subnet"
+  vpc             = ibm_is_vpc.manual.id
+  zone            = "us-south-2"
+  ipv4_cidr_block = "10.10.64.0/24"
+}
+
+# Subnets of VPCs with default address prefixes are not checked for containment
+resource "ibm_is_vpc" "auto" {
+  name = "auto-vpc"
+}
+
+resource "ibm_is_subnet" "auto" {
+  name            = "auto-subnet"
+  vpc             = ibm_is_vpc.auto.id
+  zone            = "us-south-1"
+  ipv4_cidr_block = "10.240.0.0/24"
+}
+
+resource "ibm_is_subnet" "allocated" {
+  name                     = "allocated-subnet"
+  vpc                      = ibm_is_vpc.auto.id
+  zone                     = "us-south-2"
+  total_ipv4_address_count = 256
+}
@@ -0,0 +1,14 @@
+data "ibm_is_image" "ubuntu" {
+  name = "ibm-ubuntu-24-04-6-minimal-amd64-1"
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example"
+  profile = "bx2-2x8"
+  image   = data.ibm_is_image.ubuntu.id
+  zone    = "us-south-1"
+
+  boot_volume {
+    size = 50
+  }
+}
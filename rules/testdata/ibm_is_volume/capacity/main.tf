@@ -0,0 +1,37 @@
+resource "ibm_is_volume" "too_large" {
+  name     = "too-large"
+  profile  = "general-purpose"
+  capacity = 20000
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_volume" "tier_too_large" {
+  name     = "tier-too-large"
+  profile  = "10iops-tier"
+  capacity = 5000
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_volume" "too_small" {
+  name     = "too-small"
+  capacity = 5
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_volume" "invalid_profile" {
+  name     = "invalid-profile"
+  profile  = "ultra-tier"
+  capacity = 100
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  zone    = "us-south-1"
+
+  boot_volume {
+    size = 300
+  }
+}
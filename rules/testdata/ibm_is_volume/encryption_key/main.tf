@@ -0,0 +1,17 @@
+resource "ibm_is_volume" "key_id" {
+  name           = "key-id"
+  capacity       = 100
+  encryption_key = "7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+  zone           = "us-south-1"
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  zone    = "us-south-1"
+
+  boot_volume {
+    encryption = "crn:v1:bluemix:public:cloud-object-storage:global:a/0123456789abcdef0123456789abcdef:5f2d9c1e-3b4a-4c6d-8e7f-9a0b1c2d3e4f::"
+  }
+}
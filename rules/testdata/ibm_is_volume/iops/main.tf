@@ -0,0 +1,37 @@
+resource "ibm_is_volume" "tiered" {
+  name     = "tiered"
+  profile  = "general-purpose"
+  capacity = 100
+  iops     = 3000
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_volume" "custom_without_iops" {
+  name     = "custom-without-iops"
+  profile  = "custom"
+  capacity = 100
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_volume" "custom_too_many_iops" {
+  name     = "custom-too-many-iops"
+  profile  = "custom"
+  capacity = 100
+  iops     = 50000
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  zone    = "us-south-1"
+
+  volume_attachments {
+    name = "sdp"
+    volume_prototype {
+      profile  = "sdp"
+      capacity = 500
+    }
+  }
+}
@@ -0,0 +1,46 @@
+data "ibm_is_image" "ubuntu" {
+  name = "ibm-ubuntu-24-04-6-minimal-amd64-1"
+}
+
+resource "ibm_is_volume" "general_purpose" {
+  name     = "general-purpose"
+  profile  = "general-purpose"
+  capacity = 100
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_volume" "custom" {
+  name           = "custom"
+  profile        = "custom"
+  capacity       = 200
+  iops           = 1000
+  encryption_key = "crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef0123456789abcdef:5f2d9c1e-3b4a-4c6d-8e7f-9a0b1c2d3e4f:key:7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+  zone           = "us-south-1"
+}
+
+resource "ibm_is_volume" "default_profile" {
+  name = "default-profile"
+  zone = "us-south-1"
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example"
+  profile = "bx2-2x8"
+  image   = data.ibm_is_image.ubuntu.id
+  zone    = "us-south-1"
+  volumes = [ibm_is_volume.general_purpose.id, ibm_is_volume.custom.id]
+
+  boot_volume {
+    size       = 100
+    encryption = "crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef0123456789abcdef:5f2d9c1e-3b4a-4c6d-8e7f-9a0b1c2d3e4f:key:7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+  }
+
+  volume_attachments {
+    name = "sdp"
+    volume_prototype {
+      profile  = "sdp"
+      capacity = 500
+      iops     = 10000
+    }
+  }
+}
@@ -0,0 +1,27 @@
+resource "ibm_is_volume" "zone1" {
+  name = "zone1"
+  zone = "us-south-1"
+}
+
+resource "ibm_is_volume" "zone2" {
+  name = "zone2"
+  zone = "us-south-2"
+}
+
+resource "ibm_is_volume" "zone2_attachment" {
+  name = "zone2-attachment"
+  zone = "us-south-2"
+}
+
+resource "ibm_is_instance" "example" {
+  name    = "example"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  zone    = "us-south-1"
+  volumes = [ibm_is_volume.zone1.id, ibm_is_volume.zone2.id]
+
+  volume_attachments {
+    name   = "attachment"
+    volume = ibm_is_volume.zone2_attachment.id
+  }
+}
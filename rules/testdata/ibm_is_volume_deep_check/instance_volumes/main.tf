@@ -0,0 +1,25 @@
+resource "ibm_is_instance" "example" {
+  name    = "example"
+  profile = "bx2-2x8"
+  image   = "r006-d08a6b5f-1e7a-4c15-9a2e-6fbc0bd3d53c"
+  vpc     = "r006-5b3a2f1e-7c4d-4e8f-9a0b-1c2d3e4f5a6b"
+  zone    = "us-south-1"
+
+  boot_volume {
+    profile = "sdp"
+    size    = 100
+    iops    = 3000
+  }
+
+  volume_attachments {
+    volume_prototype {
+      profile  = "custom"
+      capacity = 200
+      iops     = 1000
+    }
+  }
+
+  primary_network_interface {
+    subnet = "0717-8c6e3a2b-1d4f-4a5e-9b7c-2e3f4a5b6c7d"
+  }
+}
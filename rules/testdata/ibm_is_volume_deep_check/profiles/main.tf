@@ -0,0 +1,14 @@
+resource "ibm_is_volume" "available" {
+  name     = "available"
+  profile  = "general-purpose"
+  capacity = 100
+  zone     = "us-south-1"
+}
+
+resource "ibm_is_volume" "unavailable" {
+  name     = "unavailable"
+  profile  = "sdp"
+  capacity = 100
+  iops     = 3000
+  zone     = "us-south-1"
+}
@@ -0,0 +1,3 @@
+resource "ibm_is_vpc" "example" {
+  address_prefix_management = "manual"
+}
@@ -0,0 +1,3 @@
+resource "ibm_is_vpc" "example" {
+  name = "example-vpc"
+}
@@ -0,0 +1,24 @@
+# A child module receives its provider configurations from its caller
+terraform {
+  required_providers {
+    ibm = {
+      source                = "IBM-Cloud/ibm"
+      configuration_aliases = [ibm.primary, ibm.secondary]
+    }
+  }
+}
+
+resource "ibm_is_vpc" "primary" {
+  provider = ibm.primary
+  name     = "primary-vpc"
+}
+
+resource "ibm_is_vpc" "secondary" {
+  provider = ibm.secondary
+  name     = "secondary-vpc"
+}
+
+resource "ibm_is_vpc" "tertiary" {
+  provider = ibm.tertiary
+  name     = "tertiary-vpc"
+}
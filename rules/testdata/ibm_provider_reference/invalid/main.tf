@@ -0,0 +1,19 @@
+provider "ibm" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibm_is_vpc" "too_long" {
+  provider = ibm.eu.extra
+  name     = "too-long-vpc"
+}
+
+resource "ibm_is_vpc" "quoted_too_long" {
+  provider = "ibm.eu.extra"
+  name     = "quoted-too-long-vpc"
+}
+
+resource "ibm_is_vpc" "index" {
+  provider = ibm["eu"]
+  name     = "index-vpc"
+}
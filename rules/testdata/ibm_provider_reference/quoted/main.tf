@@ -0,0 +1,13 @@
+provider "ibm" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibm_is_vpc" "eu" {
+  provider = "ibm.eu"
+  name     = "eu-vpc"
+}
+
+data "ibm_is_images" "default" {
+  provider = "ibm"
+}
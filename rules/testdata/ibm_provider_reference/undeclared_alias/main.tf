@@ -0,0 +1,13 @@
+provider "ibm" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibm_is_vpc" "us" {
+  provider = ibm.us
+  name     = "us-vpc"
+}
+
+data "ibm_is_images" "jp" {
+  provider = ibm.jp
+}
@@ -0,0 +1,15 @@
+provider "ibm" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibm_is_vpc" "eu" {
+  provider = IBM.eu
+  name     = "eu-vpc"
+}
+
+# Quoted and unnormalized references are fixed at once
+resource "ibm_is_subnet" "eu" {
+  provider = "Ibm.eu"
+  name     = "eu-subnet"
+}
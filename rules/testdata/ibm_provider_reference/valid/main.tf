@@ -0,0 +1,32 @@
+provider "ibm" {
+  region = "us-south"
+}
+
+provider "ibm" {
+  alias  = "eu"
+  region = "eu-de"
+}
+
+resource "ibm_is_vpc" "default" {
+  name = "default-vpc"
+}
+
+resource "ibm_is_vpc" "explicit" {
+  provider = ibm
+  name     = "explicit-vpc"
+}
+
+resource "ibm_is_vpc" "eu" {
+  provider = ibm.eu
+  name     = "eu-vpc"
+}
+
+data "ibm_is_images" "eu" {
+  provider = ibm.eu
+}
+
+# Resources of other providers are not checked
+resource "aws_vpc" "example" {
+  provider   = aws.undeclared
+  cidr_block = "10.0.0.0/16"
+}
@@ -0,0 +1,4 @@
+rule "ibm_resource_group" {
+  enabled        = true
+  allow_multiple = true
+}
@@ -0,0 +1,5 @@
+rule "ibm_resource_group" {
+  enabled        = true
+  allowed        = ["4f6b3a8c2d1e4f5a9b8c7d6e5f4a3b2c"]
+  allow_multiple = true
+}
@@ -0,0 +1,7 @@
+resource "ibm_is_vpc" "example" {
+  name = "example-vpc"
+}
+
+resource "ibm_container_vpc_cluster" "example" {
+  name = "example-cluster"
+}
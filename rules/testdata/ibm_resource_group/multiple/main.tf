@@ -0,0 +1,23 @@
+variable "resource_group_id" {
+  type    = string
+  default = "4f6b3a8c2d1e4f5a9b8c7d6e5f4a3b2c"
+}
+
+data "ibm_resource_group" "network" {
+  name = "network"
+}
+
+resource "ibm_is_vpc" "example" {
+  name           = "example-vpc"
+  resource_group = data.ibm_resource_group.network.id
+}
+
+resource "ibm_is_subnet" "example" {
+  name           = "example-subnet"
+  resource_group = var.resource_group_id
+}
+
+resource "ibm_is_security_group" "example" {
+  name           = "example-sg"
+  resource_group = "9a8b7c6d5e4f4a3b2c1d0e9f8a7b6c5d"
+}
@@ -0,0 +1,5 @@
+rule "ibm_resource_group" {
+  enabled             = true
+  require_data_source = true
+  allow_multiple      = true
+}
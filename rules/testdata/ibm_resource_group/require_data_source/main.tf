@@ -0,0 +1,27 @@
+variable "resource_group_id" {
+  type    = string
+  default = "4f6b3a8c2d1e4f5a9b8c7d6e5f4a3b2c"
+}
+
+data "ibm_resource_group" "network" {
+  name = "network"
+}
+
+resource "ibm_resource_group" "managed" {
+  name = "managed"
+}
+
+resource "ibm_is_vpc" "example" {
+  name           = "example-vpc"
+  resource_group = data.ibm_resource_group.network.id
+}
+
+resource "ibm_is_subnet" "example" {
+  name           = "example-subnet"
+  resource_group = var.resource_group_id
+}
+
+resource "ibm_is_security_group" "example" {
+  name           = "example-sg"
+  resource_group = ibm_resource_group.managed.id
+}
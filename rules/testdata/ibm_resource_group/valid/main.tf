@@ -0,0 +1,21 @@
+data "ibm_resource_group" "network" {
+  name = "network"
+}
+
+resource "ibm_is_vpc" "example" {
+  name           = "example-vpc"
+  resource_group = data.ibm_resource_group.network.id
+}
+
+resource "ibm_resource_instance" "example" {
+  name              = "example-cos"
+  service           = "cloud-object-storage"
+  plan              = "standard"
+  location          = "global"
+  resource_group_id = data.ibm_resource_group.network.id
+}
+
+# Resource types without a resource group are not checked
+resource "ibm_is_vpc_address_prefix" "example" {
+  name = "example-prefix"
+}
@@ -0,0 +1,15 @@
+rule "ibm_resource_naming" {
+  enabled = true
+
+  placeholders = {
+    env = "dev|stage|prod"
+  }
+
+  format "ibm_is_vpc" {
+    template = "$${env}-$${app}-vpc"
+  }
+
+  format "ibm_is_subnet" {
+    pattern = "^[a-z]+-(app|db)-subnet-[0-9]+$"
+  }
+}
@@ -0,0 +1,24 @@
+resource "ibm_is_vpc" "prod" {
+  name = "prod-shop-vpc"
+}
+
+resource "ibm_is_vpc" "unknown_env" {
+  name = "test-shop-vpc"
+}
+
+resource "ibm_is_vpc" "no_app" {
+  name = "dev-vpc"
+}
+
+resource "ibm_is_subnet" "app" {
+  name = "prod-app-subnet-1"
+}
+
+resource "ibm_is_subnet" "web" {
+  name = "prod-web-subnet-1"
+}
+
+# Resource types without a format only need valid names
+resource "ibm_is_security_group" "example" {
+  name = "anything-goes"
+}
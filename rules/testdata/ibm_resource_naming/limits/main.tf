@@ -0,0 +1,40 @@
+variable "vpc_name" {
+  type    = string
+  default = "SharedVPC"
+}
+
+resource "ibm_is_vpc" "fixable" {
+  name = "ExampleVPC"
+}
+
+resource "ibm_is_vpc" "underscore" {
+  name = "Example_VPC"
+}
+
+resource "ibm_is_vpc" "variable" {
+  name = var.vpc_name
+}
+
+resource "ibm_is_vpc" "digit" {
+  name = "1-vpc"
+}
+
+resource "ibm_is_subnet" "too_long" {
+  name = "a-subnet-name-that-is-much-longer-than-the-sixty-three-character-limit"
+}
+
+resource "ibm_is_subnet" "trailing_hyphen" {
+  name = "app-subnet-"
+}
+
+resource "ibm_is_subnet" "app" {
+  name = "app-subnet"
+}
+
+resource "ibm_is_subnet" "duplicate" {
+  name = "app-subnet"
+}
+
+resource "ibm_is_subnet" "mixed_case" {
+  name = "db-Subnet"
+}
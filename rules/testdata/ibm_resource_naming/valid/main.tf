@@ -0,0 +1,22 @@
+variable "prefix" {
+  type    = string
+  default = "prod"
+}
+
+resource "ibm_is_vpc" "example" {
+  name = "${var.prefix}-shop-vpc"
+}
+
+resource "ibm_is_subnet" "app" {
+  name = "prod-app-subnet-1"
+}
+
+# A subnet may share the name of a VPC
+resource "ibm_is_subnet" "vpc" {
+  name = "prod-shop-vpc"
+}
+
+# Only VPC resources are checked
+resource "ibm_resource_group" "example" {
+  name = "Shop_Resources"
+}
@@ -0,0 +1,11 @@
+resource "ibm_is_vpc" "example" {
+  name = "example-vpc"
+  tags = ["env:prod", "Env:prod", "owner:platform-team"]
+}
+
+# Tags and access tags may share a key
+resource "ibm_is_subnet" "example" {
+  name        = "example-subnet"
+  tags        = ["env:prod"]
+  access_tags = ["env:prod"]
+}
@@ -0,0 +1,20 @@
+variable "owner" {
+  type    = string
+  default = "platform/team"
+}
+
+resource "ibm_is_vpc" "example" {
+  name = "example-vpc"
+  tags = [
+    "",
+    "env=prod",
+    "owner:${var.owner}",
+    "cost-center:",
+    "a-tag-that-is-much-longer-than-the-limit-of-one-hundred-and-twenty-eight-characters-set-by-the-global-search-and-tagging-api-of-ibm-cloud",
+  ]
+}
+
+resource "ibm_is_subnet" "example" {
+  name        = "example-subnet"
+  access_tags = ["shop"]
+}
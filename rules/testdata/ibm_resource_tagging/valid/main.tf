@@ -0,0 +1,20 @@
+variable "tags" {
+  type    = list(string)
+  default = ["env:prod", "owner:platform-team"]
+}
+
+resource "ibm_is_vpc" "example" {
+  name        = "example-vpc"
+  tags        = var.tags
+  access_tags = ["project:shop"]
+}
+
+# Without required tags, resources do not need any tags
+resource "ibm_is_subnet" "example" {
+  name = "example-subnet"
+}
+
+resource "ibm_is_security_group" "example" {
+  name = "example-sg"
+  tags = ["public", "cost-center:1234"]
+}
@@ -0,0 +1,10 @@
+# Tags without a value do not provide a key, so they do not satisfy required tags
+resource "ibm_is_security_group" "bare" {
+  name = "bare-sg"
+  tags = ["env", "owner", "cost-center"]
+}
+
+resource "ibm_is_security_group" "mixed" {
+  name = "mixed-sg"
+  tags = ["env", "env:prod", "owner:team-a", "cost-center"]
+}
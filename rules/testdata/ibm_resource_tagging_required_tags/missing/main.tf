@@ -0,0 +1,8 @@
+resource "ibm_is_vpc" "example" {
+  name = "example-vpc"
+  tags = ["env:prod"]
+}
+
+resource "ibm_is_subnet" "example" {
+  name = "example-subnet"
+}
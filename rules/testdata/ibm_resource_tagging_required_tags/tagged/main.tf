@@ -0,0 +1,16 @@
+resource "ibm_is_vpc" "example" {
+  name = "example-vpc"
+  tags = ["Env:prod", "owner:platform-team", "cost-center:1234"]
+}
+
+# Required tags may be access tags
+resource "ibm_is_subnet" "example" {
+  name        = "example-subnet"
+  tags        = ["env:prod", "owner:platform-team"]
+  access_tags = ["cost-center:1234"]
+}
+
+# Resource types that do not support tags are not checked
+resource "ibm_is_vpc_address_prefix" "example" {
+  name = "example-prefix"
+}
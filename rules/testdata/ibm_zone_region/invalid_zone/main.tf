@@ -0,0 +1,10 @@
+resource "ibm_is_public_gateway" "example" {
+  name = "example-gateway"
+  vpc  = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone = "us-south-4"
+}
+
+resource "ibm_is_instance" "example" {
+  name = "example-instance"
+  zone = "dal10"
+}
@@ -0,0 +1,14 @@
+# Without a provider block or IC_REGION, the region is unknown and zones of
+# any region are accepted
+resource "ibm_is_subnet" "frankfurt" {
+  name                     = "frankfurt-subnet"
+  vpc                      = "r010-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "eu-de-1"
+  total_ipv4_address_count = 256
+}
+
+resource "ibm_is_volume" "dallas" {
+  name    = "dallas-volume"
+  profile = "general-purpose"
+  zone    = "us-south-2"
+}
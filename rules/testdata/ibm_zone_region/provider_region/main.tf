@@ -0,0 +1,37 @@
+provider "ibm" {
+  region = "us-south"
+}
+
+provider "ibm" {
+  alias  = "frankfurt"
+  region = "eu-de"
+}
+
+resource "ibm_is_subnet" "dallas" {
+  name                     = "dallas-subnet"
+  vpc                      = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "us-south-1"
+  total_ipv4_address_count = 256
+}
+
+resource "ibm_is_subnet" "wrong_region" {
+  name                     = "wrong-region-subnet"
+  vpc                      = "r006-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "eu-de-1"
+  total_ipv4_address_count = 256
+}
+
+resource "ibm_is_subnet" "frankfurt" {
+  provider                 = ibm.frankfurt
+  name                     = "frankfurt-subnet"
+  vpc                      = "r010-1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+  zone                     = "eu-de-1"
+  total_ipv4_address_count = 256
+}
+
+resource "ibm_is_volume" "wrong_alias_region" {
+  provider = ibm.frankfurt
+  name     = "wrong-alias-region-volume"
+  profile  = "general-purpose"
+  zone     = "us-south-1"
+}
@@ -0,0 +1,272 @@
+// Command catalog-gen refreshes the offline catalog compiled into the ibm package.
+//
+// It queries the VPC API with the credentials in IBMCLOUD_API_KEY and writes
+// a Go source file containing instance profiles, bare metal profiles, volume
+// profiles, stock images, regions and zones.
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"text/template"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+)
+
+type region struct {
+	Name  string
+	Zones []string
+}
+
+type catalog struct {
+	Version           string
+	InstanceProfiles  []ibm.InstanceProfile
+	BareMetalProfiles []ibm.BareMetalProfile
+	VolumeProfiles    []ibm.VolumeProfile
+	Images            []ibm.Image
+	Regions           []region
+}
+
+func main() {
+	output := flag.String("output", "ibm/catalog_gen.go", "path of the generated file")
+	regionName := flag.String("region", "us-south", "region used to list profiles and images")
+	version := flag.String("version", time.Now().UTC().Format("2006-01-02"), "catalog version")
+	flag.Parse()
+
+	apiKey := os.Getenv("IBMCLOUD_API_KEY")
+	if apiKey == "" {
+		log.Fatal("IBMCLOUD_API_KEY must be set to refresh the catalog")
+	}
+
+	client, err := ibm.NewClient(ibm.Credentials{
+		APIKey: apiKey,
+		Region: *regionName,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c, err := fetch(context.Background(), client.VPC)
+	if err != nil {
+		log.Fatal(err)
+	}
+	c.Version = *version
+
+	src, err := render(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func fetch(ctx context.Context, vpc *vpcv1.VpcV1) (*catalog, error) {
+	c := &catalog{}
+
+	instanceProfiles, _, err := vpc.ListInstanceProfilesWithContext(ctx, &vpcv1.ListInstanceProfilesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instance profiles: %w", err)
+	}
+	for _, p := range instanceProfiles.Profiles {
+		profile := ibm.InstanceProfile{
+			Name:   *p.Name,
+			Family: *p.Family,
+		}
+		if p.VcpuArchitecture != nil && p.VcpuArchitecture.Value != nil {
+			profile.Architecture = *p.VcpuArchitecture.Value
+		}
+		if v, ok := p.VcpuCount.(*vpcv1.InstanceProfileVcpu); ok {
+			profile.VCPU = fixedOrMax(v.Value, v.Max)
+		}
+		if v, ok := p.Memory.(*vpcv1.InstanceProfileMemory); ok {
+			profile.MemoryGiB = fixedOrMax(v.Value, v.Max)
+		}
+		if v, ok := p.GpuCount.(*vpcv1.InstanceProfileGpu); ok {
+			profile.GPUCount = fixedOrMax(v.Value, v.Max)
+		}
+		if p.GpuModel != nil && len(p.GpuModel.Values) > 0 {
+			profile.GPUModel = p.GpuModel.Values[0]
+		}
+		c.InstanceProfiles = append(c.InstanceProfiles, profile)
+	}
+
+	bareMetalPager, err := vpc.NewBareMetalServerProfilesPager(&vpcv1.ListBareMetalServerProfilesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bare metal server profiles: %w", err)
+	}
+	bareMetalProfiles, err := bareMetalPager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bare metal server profiles: %w", err)
+	}
+	for _, p := range bareMetalProfiles {
+		profile := ibm.BareMetalProfile{
+			Name:   *p.Name,
+			Family: *p.Family,
+		}
+		if p.CpuArchitecture != nil && p.CpuArchitecture.Value != nil {
+			profile.Architecture = *p.CpuArchitecture.Value
+		}
+		if v, ok := p.CpuCoreCount.(*vpcv1.BareMetalServerProfileCpuCoreCount); ok {
+			profile.CPUCores = fixedOrMax(v.Value, v.Max)
+		}
+		if v, ok := p.Memory.(*vpcv1.BareMetalServerProfileMemory); ok {
+			profile.MemoryGiB = fixedOrMax(v.Value, v.Max)
+		}
+		c.BareMetalProfiles = append(c.BareMetalProfiles, profile)
+	}
+
+	volumePager, err := vpc.NewVolumeProfilesPager(&vpcv1.ListVolumeProfilesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume profiles: %w", err)
+	}
+	volumeProfiles, err := volumePager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list volume profiles: %w", err)
+	}
+	for _, p := range volumeProfiles {
+		profile := ibm.VolumeProfile{
+			Name:   *p.Name,
+			Family: *p.Family,
+		}
+		if v, ok := p.Capacity.(*vpcv1.VolumeProfileCapacity); ok {
+			profile.MinCapacity = fixedOrMin(v.Value, v.Min)
+			profile.MaxCapacity = fixedOrMax(v.Value, v.Max)
+		}
+		if v, ok := p.BootCapacity.(*vpcv1.VolumeProfileBootCapacity); ok {
+			profile.MaxBootCapacity = fixedOrMax(v.Value, v.Max)
+		}
+		if v, ok := p.Iops.(*vpcv1.VolumeProfileIops); ok {
+			profile.MinIOPS = fixedOrMin(v.Value, v.Min)
+			profile.MaxIOPS = fixedOrMax(v.Value, v.Max)
+		}
+		c.VolumeProfiles = append(c.VolumeProfiles, profile)
+	}
+
+	imagePager, err := vpc.NewImagesPager(&vpcv1.ListImagesOptions{
+		Visibility: core.StringPtr(vpcv1.ListImagesOptionsVisibilityPublicConst),
+		Status:     []string{vpcv1.ListImagesOptionsStatusAvailableConst},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+	images, err := imagePager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+	for _, i := range images {
+		if i.OperatingSystem == nil {
+			continue
+		}
+		image := ibm.Image{
+			Name:            *i.Name,
+			OperatingSystem: *i.OperatingSystem.Name,
+			OSFamily:        *i.OperatingSystem.Family,
+			Architecture:    *i.OperatingSystem.Architecture,
+		}
+		if i.MinimumProvisionedSize != nil {
+			image.MinimumProvisionedSize = int(*i.MinimumProvisionedSize)
+		}
+		c.Images = append(c.Images, image)
+	}
+
+	regions, _, err := vpc.ListRegionsWithContext(ctx, &vpcv1.ListRegionsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list regions: %w", err)
+	}
+	for _, r := range regions.Regions {
+		zones, _, err := vpc.ListRegionZonesWithContext(ctx, &vpcv1.ListRegionZonesOptions{RegionName: r.Name})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list zones in region %s: %w", *r.Name, err)
+		}
+		reg := region{Name: *r.Name}
+		for _, z := range zones.Zones {
+			reg.Zones = append(reg.Zones, *z.Name)
+		}
+		sort.Strings(reg.Zones)
+		c.Regions = append(c.Regions, reg)
+	}
+
+	sort.Slice(c.InstanceProfiles, func(i, j int) bool { return c.InstanceProfiles[i].Name < c.InstanceProfiles[j].Name })
+	sort.Slice(c.BareMetalProfiles, func(i, j int) bool { return c.BareMetalProfiles[i].Name < c.BareMetalProfiles[j].Name })
+	sort.Slice(c.VolumeProfiles, func(i, j int) bool { return c.VolumeProfiles[i].Name < c.VolumeProfiles[j].Name })
+	sort.Slice(c.Images, func(i, j int) bool { return c.Images[i].Name < c.Images[j].Name })
+	sort.Slice(c.Regions, func(i, j int) bool { return c.Regions[i].Name < c.Regions[j].Name })
+
+	return c, nil
+}
+
+func fixedOrMax(value, max *int64) int {
+	if value != nil {
+		return int(*value)
+	}
+	if max != nil {
+		return int(*max)
+	}
+	return 0
+}
+
+func fixedOrMin(value, min *int64) int {
+	if value != nil {
+		return int(*value)
+	}
+	if min != nil {
+		return int(*min)
+	}
+	return 0
+}
+
+func render(c *catalog) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := catalogTemplate.Execute(&buf, c); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+var catalogTemplate = template.Must(template.New("catalog").Parse(`// Code generated by tools/catalog-gen; DO NOT EDIT.
+
+package ibm
+
+// CatalogVersion is the date the offline catalog was generated from the VPC API.
+const CatalogVersion = {{printf "%q" .Version}}
+
+var catalogInstanceProfiles = map[string]InstanceProfile{
+{{- range .InstanceProfiles}}
+	{{printf "%q" .Name}}: {Name: {{printf "%q" .Name}}, Family: {{printf "%q" .Family}}, Architecture: {{printf "%q" .Architecture}}, VCPU: {{.VCPU}}, MemoryGiB: {{.MemoryGiB}}{{if .GPUCount}}, GPUCount: {{.GPUCount}}, GPUModel: {{printf "%q" .GPUModel}}{{end}}},
+{{- end}}
+}
+
+var catalogBareMetalProfiles = map[string]BareMetalProfile{
+{{- range .BareMetalProfiles}}
+	{{printf "%q" .Name}}: {Name: {{printf "%q" .Name}}, Family: {{printf "%q" .Family}}, Architecture: {{printf "%q" .Architecture}}, CPUCores: {{.CPUCores}}, MemoryGiB: {{.MemoryGiB}}},
+{{- end}}
+}
+
+var catalogVolumeProfiles = map[string]VolumeProfile{
+{{- range .VolumeProfiles}}
+	{{printf "%q" .Name}}: {Name: {{printf "%q" .Name}}, Family: {{printf "%q" .Family}}, MinCapacity: {{.MinCapacity}}, MaxCapacity: {{.MaxCapacity}}, MaxBootCapacity: {{.MaxBootCapacity}}, MinIOPS: {{.MinIOPS}}, MaxIOPS: {{.MaxIOPS}}},
+{{- end}}
+}
+
+var catalogImages = map[string]Image{
+{{- range .Images}}
+	{{printf "%q" .Name}}: {Name: {{printf "%q" .Name}}, OperatingSystem: {{printf "%q" .OperatingSystem}}, OSFamily: {{printf "%q" .OSFamily}}, Architecture: {{printf "%q" .Architecture}}, MinimumProvisionedSize: {{.MinimumProvisionedSize}}},
+{{- end}}
+}
+
+var catalogRegions = map[string][]string{
+{{- range .Regions}}
+	{{printf "%q" .Name}}: { {{- range $i, $z := .Zones}}{{if $i}}, {{end}}{{printf "%q" $z}}{{end -}} },
+{{- end}}
+}
+`))
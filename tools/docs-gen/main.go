@@ -0,0 +1,252 @@
+// Command docs-gen renders the rule documentation from the rules of the ruleset.
+//
+// It writes the title and metadata table of each docs/rules/<name>.md, keeping
+// the hand-written description, examples and fixes below them, creates a
+// skeleton for rules without documentation, and renders the rule index
+// docs/rules/README.md. With -check, it writes nothing and fails when the
+// committed documentation is out of date.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/ibm"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+	"github.com/uibm/tflint-ruleset-ibm/rules"
+)
+
+const (
+	// indexFile is the name of the rule index
+	indexFile = "README.md"
+	// metadataBegin and metadataEnd enclose the generated metadata table of a rule document
+	metadataBegin = "<!-- BEGIN_RULE_METADATA: generated by tools/docs-gen, do not edit -->"
+	metadataEnd   = "<!-- END_RULE_METADATA -->"
+)
+
+// skeleton is the body of a new rule document
+const skeleton = `This rule checks ...
+
+## Example
+
+## Why
+
+## How To Fix
+`
+
+var indexTemplate = template.Must(template.New("index").Parse(`# Rules
+
+This index is generated by ` + "`make docs`" + ` from the rules of the ruleset.
+Rules that require deep checking only report issues when ` + "`deep_check`" + ` is enabled in the [plugin configuration](../configuration.md#deep-checking).
+{{ range .Categories }}
+## {{ .Name }}
+
+|Name|Severity|Enabled|Deep Check|
+|---|---|---|---|
+{{- range .Rules }}
+|[{{ .Name }}]({{ .Name }}.md)|{{ .Severity }}|{{ if .Enabled }}✔{{ end }}|{{ .DeepCheck }}|
+{{- end }}
+{{ end -}}
+`))
+
+// rule is the documentation of a rule
+type rule struct {
+	Name     string
+	Severity string
+	Enabled  bool
+	// DeepCheck is "Optional", "Required", or empty for static rules
+	DeepCheck string
+	Category  string
+}
+
+type category struct {
+	Name  string
+	Rules []rule
+}
+
+func main() {
+	dir := flag.String("dir", "docs/rules", "directory of the rule documentation")
+	check := flag.Bool("check", false, "fail when the documentation is out of date instead of writing it")
+	flag.Parse()
+
+	files, err := render(*dir, ibm.NewRuleSet(rules.Rules).Rules)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var drifted []string
+	for _, path := range sortedKeys(files) {
+		current, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			log.Fatal(err)
+		}
+		if bytes.Equal(current, files[path]) {
+			continue
+		}
+
+		if *check {
+			drifted = append(drifted, path)
+			continue
+		}
+		if err := os.WriteFile(path, files[path], 0o644); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("wrote %s\n", path)
+	}
+
+	if len(drifted) > 0 {
+		log.Fatalf("documentation is out of date, run `make docs`:\n  %s", strings.Join(drifted, "\n  "))
+	}
+}
+
+// render returns the contents of every generated file by path
+func render(dir string, ruleset []tflint.Rule) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	categories := map[string]*category{}
+	documented := map[string]bool{indexFile: true}
+
+	for _, r := range ruleset {
+		doc, err := describe(r)
+		if err != nil {
+			return nil, err
+		}
+
+		path := filepath.Join(dir, doc.Name+".md")
+		src, err := renderRule(path, doc)
+		if err != nil {
+			return nil, err
+		}
+		files[path] = src
+		documented[doc.Name+".md"] = true
+
+		if categories[doc.Category] == nil {
+			categories[doc.Category] = &category{Name: doc.Category}
+		}
+		categories[doc.Category].Rules = append(categories[doc.Category].Rules, doc)
+	}
+
+	// Documents without a rule would be linked from nowhere, and usually mean a rule was renamed
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if filepath.Ext(entry.Name()) == ".md" && !documented[entry.Name()] {
+			return nil, fmt.Errorf("%s does not document any rule", filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	var index struct{ Categories []*category }
+	for _, name := range sortedKeys(categories) {
+		c := categories[name]
+		sort.Slice(c.Rules, func(i, j int) bool { return c.Rules[i].Name < c.Rules[j].Name })
+		index.Categories = append(index.Categories, c)
+	}
+	var b bytes.Buffer
+	if err := indexTemplate.Execute(&b, index); err != nil {
+		return nil, err
+	}
+	files[filepath.Join(dir, indexFile)] = b.Bytes()
+
+	return files, nil
+}
+
+// describe returns the documentation of a rule from its metadata
+func describe(r tflint.Rule) (rule, error) {
+	metadata, ok := r.Metadata().(rules.Metadata)
+	if !ok {
+		return rule{}, fmt.Errorf("%s: Metadata must return rules.Metadata", r.Name())
+	}
+	if metadata.Category == "" {
+		return rule{}, fmt.Errorf("%s: category is not set", r.Name())
+	}
+	if r.Link() != project.ReferenceLink(r.Name()) {
+		return rule{}, fmt.Errorf("%s: Link must return project.ReferenceLink(%q), got %q", r.Name(), r.Name(), r.Link())
+	}
+
+	doc := rule{
+		Name:     r.Name(),
+		Severity: r.Severity().String(),
+		Enabled:  r.Enabled(),
+		Category: metadata.Category,
+	}
+	switch metadata.DeepCheck {
+	case rules.DeepCheckNone:
+	case rules.DeepCheckOptional:
+		doc.DeepCheck = "Optional"
+	case rules.DeepCheckRequired:
+		doc.DeepCheck = "Required"
+	default:
+		return rule{}, fmt.Errorf("%s: unknown deep check %q", r.Name(), metadata.DeepCheck)
+	}
+	return doc, nil
+}
+
+// renderRule renders the title and metadata table of a rule document,
+// followed by the hand-written body of the existing document, if any
+func renderRule(path string, doc rule) ([]byte, error) {
+	body := skeleton
+	src, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		body = documentBody(string(src))
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+
+	enabled, deepCheck := "No", "Not used"
+	if doc.Enabled {
+		enabled = "Yes"
+	}
+	if doc.DeepCheck != "" {
+		deepCheck = doc.DeepCheck
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# `%s`\n\n", doc.Name)
+	fmt.Fprintln(&b, metadataBegin)
+	fmt.Fprintln(&b, "|Severity|Enabled by default|Deep check|Category|")
+	fmt.Fprintln(&b, "|---|---|---|---|")
+	fmt.Fprintf(&b, "|%s|%s|%s|%s|\n", doc.Severity, enabled, deepCheck, doc.Category)
+	fmt.Fprintln(&b, metadataEnd)
+	fmt.Fprintf(&b, "\n%s", body)
+	return []byte(b.String()), nil
+}
+
+// documentBody returns a rule document without its title and metadata table
+func documentBody(src string) string {
+	if strings.HasPrefix(src, "# ") {
+		if _, rest, found := strings.Cut(src, "\n"); found {
+			src = rest
+		} else {
+			src = ""
+		}
+	}
+	src = strings.TrimLeft(src, "\n")
+
+	if strings.HasPrefix(src, metadataBegin) {
+		if _, rest, found := strings.Cut(src, metadataEnd); found {
+			src = strings.TrimLeft(rest, "\n")
+		}
+	}
+
+	src = strings.TrimRight(src, "\n") + "\n"
+	return src
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/terraform-linters/tflint-plugin-sdk/tflint"
+	"github.com/uibm/tflint-ruleset-ibm/project"
+	"github.com/uibm/tflint-ruleset-ibm/rules"
+)
+
+// testRule is a rule with the given name, severity and metadata.
+// A nil metadata behaves like a rule that does not implement Metadata.
+type testRule struct {
+	tflint.DefaultRule
+	name     string
+	severity tflint.Severity
+	enabled  bool
+	metadata interface{}
+}
+
+func (r *testRule) Name() string              { return r.name }
+func (r *testRule) Enabled() bool             { return r.enabled }
+func (r *testRule) Severity() tflint.Severity { return r.severity }
+func (r *testRule) Link() string              { return project.ReferenceLink(r.name) }
+func (r *testRule) Metadata() interface{}     { return r.metadata }
+func (r *testRule) Check(tflint.Runner) error { return nil }
+
+func TestRender(t *testing.T) {
+	dir := t.TempDir()
+	documented := "# `ibm_documented`\n\n" +
+		metadataBegin + "\n|stale|table|\n" + metadataEnd + "\n\n" +
+		"This rule checks documented resources.\n"
+	if err := os.WriteFile(filepath.Join(dir, "ibm_documented.md"), []byte(documented), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := render(dir, []tflint.Rule{
+		&testRule{name: "ibm_documented", severity: tflint.ERROR, enabled: true, metadata: rules.Metadata{Category: "Compute", DeepCheck: rules.DeepCheckOptional}},
+		&testRule{name: "ibm_new", severity: tflint.WARNING, metadata: rules.Metadata{Category: "Compute", DeepCheck: rules.DeepCheckNone}},
+		&testRule{name: "ibm_api", severity: tflint.NOTICE, enabled: true, metadata: rules.Metadata{Category: "Backup", DeepCheck: rules.DeepCheckRequired}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "# `ibm_documented`\n\n" +
+		metadataBegin + "\n" +
+		"|Severity|Enabled by default|Deep check|Category|\n" +
+		"|---|---|---|---|\n" +
+		"|Error|Yes|Optional|Compute|\n" +
+		metadataEnd + "\n\n" +
+		"This rule checks documented resources.\n"
+	if diff := cmp.Diff(want, string(files[filepath.Join(dir, "ibm_documented.md")])); diff != "" {
+		t.Errorf("ibm_documented.md: %s", diff)
+	}
+
+	want = "# `ibm_new`\n\n" +
+		metadataBegin + "\n" +
+		"|Severity|Enabled by default|Deep check|Category|\n" +
+		"|---|---|---|---|\n" +
+		"|Warning|No|Not used|Compute|\n" +
+		metadataEnd + "\n\n" + skeleton
+	if diff := cmp.Diff(want, string(files[filepath.Join(dir, "ibm_new.md")])); diff != "" {
+		t.Errorf("ibm_new.md: %s", diff)
+	}
+
+	index := string(files[filepath.Join(dir, indexFile)])
+	for _, section := range []string{
+		"## Backup\n\n|Name|Severity|Enabled|Deep Check|\n|---|---|---|---|\n|[ibm_api](ibm_api.md)|Notice|✔|Required|\n",
+		"## Compute\n\n|Name|Severity|Enabled|Deep Check|\n|---|---|---|---|\n|[ibm_documented](ibm_documented.md)|Error|✔|Optional|\n|[ibm_new](ibm_new.md)|Warning|||\n",
+	} {
+		if !strings.Contains(index, section) {
+			t.Errorf("the index does not contain\n%s\ngot\n%s", section, index)
+		}
+	}
+	if strings.Index(index, "## Backup") > strings.Index(index, "## Compute") {
+		t.Error("categories are not sorted")
+	}
+}
+
+func TestRender_errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  tflint.Rule
+		stale string
+		want  string
+	}{
+		{
+			name: "without metadata",
+			rule: &testRule{name: "ibm_plain", severity: tflint.ERROR},
+			want: "ibm_plain: Metadata must return rules.Metadata",
+		},
+		{
+			name: "without category",
+			rule: &testRule{name: "ibm_plain", severity: tflint.ERROR, metadata: rules.Metadata{}},
+			want: "ibm_plain: category is not set",
+		},
+		{
+			name:  "document without a rule",
+			rule:  &testRule{name: "ibm_plain", severity: tflint.ERROR, metadata: rules.Metadata{Category: "Compute"}},
+			stale: "ibm_renamed.md",
+			want:  "ibm_renamed.md does not document any rule",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if test.stale != "" {
+				if err := os.WriteFile(filepath.Join(dir, test.stale), []byte("# stale\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, err := render(dir, []tflint.Rule{test.rule})
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("error = %v, want %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestDocumentBody(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "generated",
+			src:  "# `ibm_rule`\n\n" + metadataBegin + "\n|table|\n" + metadataEnd + "\n\nBody\n\n\n",
+			want: "Body\n",
+		},
+		{
+			name: "without markers",
+			src:  "# ibm_rule\n\nBody\n\n## Example\n",
+			want: "Body\n\n## Example\n",
+		},
+		{
+			name: "without end marker",
+			src:  "# ibm_rule\n\n" + metadataBegin + "\nBody\n",
+			want: metadataBegin + "\nBody\n",
+		},
+		{
+			name: "without title",
+			src:  "Body",
+			want: "Body\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, documentBody(test.src)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
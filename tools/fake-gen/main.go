@@ -0,0 +1,254 @@
+// Command fake-gen generates FakeClient, an in-memory implementation of the
+// Client interface of the ibm package for rule tests.
+//
+// Every method of the interface gets a function field of the same name with
+// a Func suffix, so tests only stub the methods a rule calls. It is run by
+// `go generate -run fake-gen ./ibm` whenever the interface changes.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// method is a method of the interface
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+}
+
+type param struct {
+	Name string
+	Type string
+}
+
+func main() {
+	source := flag.String("source", "vpc.go", "path of the file declaring the interface")
+	iface := flag.String("interface", "Client", "name of the interface")
+	output := flag.String("output", "fake_gen.go", "path of the generated file")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	methods, imports, err := parseInterface(fset, file, *iface)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(file.Name.Name, *iface, methods, imports)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseInterface returns the methods of the interface and the imports their types use
+func parseInterface(fset *token.FileSet, file *ast.File, name string) ([]method, []string, error) {
+	var spec *ast.InterfaceType
+	ast.Inspect(file, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok && ts.Name.Name == name {
+			spec, _ = ts.Type.(*ast.InterfaceType)
+		}
+		return spec == nil
+	})
+	if spec == nil {
+		return nil, nil, fmt.Errorf("interface %s not found", name)
+	}
+
+	// Packages are referenced by the last element of their import path
+	available := map[string]string{}
+	for _, imp := range file.Imports {
+		importPath, _ := strconv.Unquote(imp.Path.Value)
+		pkg := path.Base(importPath)
+		if imp.Name != nil {
+			pkg = imp.Name.Name
+		}
+		available[pkg] = importPath
+	}
+	used := map[string]bool{}
+
+	var methods []method
+	for _, field := range spec.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, nil, fmt.Errorf("%s embeds another interface, which is not supported", name)
+		}
+
+		m := method{Name: field.Names[0].Name}
+		for _, p := range fn.Params.List {
+			typ := exprString(fset, p.Type, available, used)
+			if len(p.Names) == 0 {
+				m.Params = append(m.Params, param{Name: fmt.Sprintf("arg%d", len(m.Params)), Type: typ})
+			}
+			for _, n := range p.Names {
+				m.Params = append(m.Params, param{Name: n.Name, Type: typ})
+			}
+		}
+		if fn.Results != nil {
+			for _, r := range fn.Results.List {
+				typ := exprString(fset, r.Type, available, used)
+				for i := 0; i < max(1, len(r.Names)); i++ {
+					m.Results = append(m.Results, typ)
+				}
+			}
+		}
+		methods = append(methods, m)
+	}
+
+	var imports []string
+	for pkg := range used {
+		imports = append(imports, available[pkg])
+	}
+	imports = append(imports, "sync")
+	sort.Strings(imports)
+	return methods, imports, nil
+}
+
+// exprString prints a type expression and marks the packages it references as used
+func exprString(fset *token.FileSet, expr ast.Expr, available map[string]string, used map[string]bool) string {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && available[ident.Name] != "" {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	var b bytes.Buffer
+	_ = printer.Fprint(&b, fset, expr)
+	return b.String()
+}
+
+// zeroValue returns the zero value of a printed type
+func zeroValue(typ string) string {
+	switch {
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case strings.HasPrefix(typ, "int") || strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "float"):
+		return "0"
+	case typ == "error" || strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "map[") ||
+		strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "func(") || strings.HasPrefix(typ, "chan ") ||
+		strings.HasPrefix(typ, "interface{"):
+		return "nil"
+	}
+	return fmt.Sprintf("*new(%s)", typ)
+}
+
+func generate(pkg string, iface string, methods []method, imports []string) ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by tools/fake-gen from the %s interface; DO NOT EDIT.\n\n", iface)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	// Standard library imports are grouped before the others
+	fmt.Fprintln(&b, "import (")
+	for _, std := range []bool{true, false} {
+		for _, imp := range imports {
+			if !strings.Contains(strings.Split(imp, "/")[0], ".") == std {
+				fmt.Fprintf(&b, "\t%q\n", imp)
+			}
+		}
+		if std {
+			fmt.Fprintln(&b)
+		}
+	}
+	fmt.Fprintln(&b, ")")
+
+	fmt.Fprintf(&b, "\n// Fake%[1]s is an in-memory %[1]s for rule tests.\n", iface)
+	fmt.Fprintln(&b, "// Each method calls the field of the same name with a Func suffix, and")
+	fmt.Fprintln(&b, "// returns zero values when it is nil. Calls are recorded in order.")
+	fmt.Fprintf(&b, "type Fake%s struct {\n", iface)
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\t%sFunc %s\n", m.Name, signature(m))
+	}
+	fmt.Fprintln(&b, "\n\tmu    sync.Mutex")
+	fmt.Fprintln(&b, "\tcalls []string")
+	fmt.Fprintln(&b, "}")
+	fmt.Fprintf(&b, "\nvar _ %[1]s = (*Fake%[1]s)(nil)\n", iface)
+
+	for _, m := range methods {
+		var params, args, zeros []string
+		for _, p := range m.Params {
+			params = append(params, p.Name+" "+p.Type)
+			args = append(args, p.Name)
+		}
+		for _, r := range m.Results {
+			zeros = append(zeros, zeroValue(r))
+		}
+
+		fmt.Fprintf(&b, "\n// %s calls %sFunc.\n", m.Name, m.Name)
+		fmt.Fprintf(&b, "func (f *Fake%s) %s(%s) %s {\n", iface, m.Name, strings.Join(params, ", "), results(m))
+		fmt.Fprintf(&b, "\tf.record(%q)\n", m.Name)
+		fmt.Fprintf(&b, "\tif f.%sFunc == nil {\n", m.Name)
+		if len(zeros) > 0 {
+			fmt.Fprintf(&b, "\t\treturn %s\n", strings.Join(zeros, ", "))
+		} else {
+			fmt.Fprintln(&b, "\t\treturn")
+		}
+		fmt.Fprintln(&b, "\t}")
+		if len(m.Results) > 0 {
+			fmt.Fprintf(&b, "\treturn f.%sFunc(%s)\n", m.Name, strings.Join(args, ", "))
+		} else {
+			fmt.Fprintf(&b, "\tf.%sFunc(%s)\n", m.Name, strings.Join(args, ", "))
+		}
+		fmt.Fprintln(&b, "}")
+	}
+
+	fmt.Fprintf(&b, `
+// Calls returns the names of the methods called so far, in order.
+func (f *Fake%s) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string{}, f.calls...)
+}
+
+func (f *Fake%s) record(method string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, method)
+}
+`, iface, iface)
+
+	return format.Source(b.Bytes())
+}
+
+// signature returns the function type of a method
+func signature(m method) string {
+	var params []string
+	for _, p := range m.Params {
+		params = append(params, p.Name+" "+p.Type)
+	}
+	return fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), results(m))
+}
+
+// results returns the result list of a method
+func results(m method) string {
+	switch len(m.Results) {
+	case 0:
+		return ""
+	case 1:
+		return m.Results[0]
+	}
+	return "(" + strings.Join(m.Results, ", ") + ")"
+}